@@ -0,0 +1,65 @@
+from google.protobuf import descriptor_pb2 as _descriptor_pb2
+from google.protobuf.internal import containers as _containers
+from google.protobuf.internal import enum_type_wrapper as _enum_type_wrapper
+from google.protobuf import descriptor as _descriptor
+from google.protobuf import message as _message
+from typing import ClassVar as _ClassVar, Iterable as _Iterable, Optional as _Optional, Union as _Union
+
+DESCRIPTOR: _descriptor.FileDescriptor
+
+class Code(int, metaclass=_enum_type_wrapper.EnumTypeWrapper):
+    __slots__ = ()
+    CODE_OK: _ClassVar[Code]
+    CODE_CANCELLED: _ClassVar[Code]
+    CODE_UNKNOWN: _ClassVar[Code]
+    CODE_INVALID_ARGUMENT: _ClassVar[Code]
+    CODE_DEADLINE_EXCEEDED: _ClassVar[Code]
+    CODE_NOT_FOUND: _ClassVar[Code]
+    CODE_ALREADY_EXISTS: _ClassVar[Code]
+    CODE_PERMISSION_DENIED: _ClassVar[Code]
+    CODE_RESOURCE_EXHAUSTED: _ClassVar[Code]
+    CODE_FAILED_PRECONDITION: _ClassVar[Code]
+    CODE_ABORTED: _ClassVar[Code]
+    CODE_OUT_OF_RANGE: _ClassVar[Code]
+    CODE_UNIMPLEMENTED: _ClassVar[Code]
+    CODE_INTERNAL: _ClassVar[Code]
+    CODE_UNAVAILABLE: _ClassVar[Code]
+    CODE_DATA_LOSS: _ClassVar[Code]
+    CODE_UNAUTHENTICATED: _ClassVar[Code]
+CODE_OK: Code
+CODE_CANCELLED: Code
+CODE_UNKNOWN: Code
+CODE_INVALID_ARGUMENT: Code
+CODE_DEADLINE_EXCEEDED: Code
+CODE_NOT_FOUND: Code
+CODE_ALREADY_EXISTS: Code
+CODE_PERMISSION_DENIED: Code
+CODE_RESOURCE_EXHAUSTED: Code
+CODE_FAILED_PRECONDITION: Code
+CODE_ABORTED: Code
+CODE_OUT_OF_RANGE: Code
+CODE_UNIMPLEMENTED: Code
+CODE_INTERNAL: Code
+CODE_UNAVAILABLE: Code
+CODE_DATA_LOSS: Code
+CODE_UNAUTHENTICATED: Code
+DOMAIN_FIELD_NUMBER: _ClassVar[int]
+domain: _descriptor.FieldDescriptor
+ERROR_FIELD_NUMBER: _ClassVar[int]
+error: _descriptor.FieldDescriptor
+
+class ErrorSpec(_message.Message):
+    __slots__ = ("code", "message", "field", "metadata", "aggregate", "external")
+    CODE_FIELD_NUMBER: _ClassVar[int]
+    MESSAGE_FIELD_NUMBER: _ClassVar[int]
+    FIELD_FIELD_NUMBER: _ClassVar[int]
+    METADATA_FIELD_NUMBER: _ClassVar[int]
+    AGGREGATE_FIELD_NUMBER: _ClassVar[int]
+    EXTERNAL_FIELD_NUMBER: _ClassVar[int]
+    code: Code
+    message: str
+    field: str
+    metadata: _containers.RepeatedScalarFieldContainer[str]
+    aggregate: bool
+    external: bool
+    def __init__(self, code: _Optional[_Union[Code, str]] = ..., message: _Optional[str] = ..., field: _Optional[str] = ..., metadata: _Optional[_Iterable[str]] = ..., aggregate: _Optional[bool] = ..., external: _Optional[bool] = ...) -> None: ...
@@ -0,0 +1,28 @@
+from google.protobuf import descriptor_pb2 as _descriptor_pb2
+from google.protobuf import descriptor as _descriptor
+from google.protobuf import message as _message
+from typing import ClassVar as _ClassVar, Mapping as _Mapping, Optional as _Optional, Union as _Union
+
+DESCRIPTOR: _descriptor.FileDescriptor
+FIELD_FIELD_NUMBER: _ClassVar[int]
+field: _descriptor.FieldDescriptor
+
+class FieldConstraints(_message.Message):
+    __slots__ = ("required", "string")
+    REQUIRED_FIELD_NUMBER: _ClassVar[int]
+    STRING_FIELD_NUMBER: _ClassVar[int]
+    required: bool
+    string: StringConstraints
+    def __init__(self, required: _Optional[bool] = ..., string: _Optional[_Union[StringConstraints, _Mapping]] = ...) -> None: ...
+
+class StringConstraints(_message.Message):
+    __slots__ = ("min_len", "max_len", "pattern", "email")
+    MIN_LEN_FIELD_NUMBER: _ClassVar[int]
+    MAX_LEN_FIELD_NUMBER: _ClassVar[int]
+    PATTERN_FIELD_NUMBER: _ClassVar[int]
+    EMAIL_FIELD_NUMBER: _ClassVar[int]
+    min_len: int
+    max_len: int
+    pattern: str
+    email: bool
+    def __init__(self, min_len: _Optional[int] = ..., max_len: _Optional[int] = ..., pattern: _Optional[str] = ..., email: _Optional[bool] = ...) -> None: ...
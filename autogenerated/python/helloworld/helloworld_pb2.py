@@ -22,28 +22,117 @@ _runtime_version.ValidateProtobufRuntimeVersion(
 _sym_db = _symbol_database.Default()
 
 
+from errorspec import errorspec_pb2 as errorspec_dot_errorspec__pb2
+from fieldspec import fieldspec_pb2 as fieldspec_dot_fieldspec__pb2
+from google.protobuf import field_mask_pb2 as google_dot_protobuf_dot_field__mask__pb2
 
 
-DESCRIPTOR = _descriptor_pool.Default().AddSerializedFile(b'\n\x1bhelloworld/helloworld.proto\x12\x0bhello_world\"E\n\x11\x43reateUserRequest\x12\x1a\n\x08username\x18\x01 \x01(\tR\x08username\x12\x14\n\x05\x65mail\x18\x02 \x01(\tR\x05\x65mail\"^\n\x12\x43reateUserResponse\x12\x17\n\x07user_id\x18\x01 \x01(\tR\x06userId\x12/\n\x06status\x18\x02 \x01(\x0e\x32\x17.hello_world.UserStatusR\x06status\"\x87\x01\n\x15\x43reateUserAltResponse\x12\x31\n\x07success\x18\x01 \x01(\x0b\x32\x15.hello_world.UserDataH\x00R\x07success\x12\x31\n\x05\x65rror\x18\x02 \x01(\x0b\x32\x19.hello_world.ErrorDetailsH\x00R\x05\x65rrorB\x08\n\x06result\"T\n\x08UserData\x12\x17\n\x07user_id\x18\x01 \x01(\tR\x06userId\x12/\n\x06status\x18\x02 \x01(\x0e\x32\x17.hello_world.UserStatusR\x06status\"<\n\x0c\x45rrorDetails\x12\x12\n\x04\x63ode\x18\x01 \x01(\tR\x04\x63ode\x12\x18\n\x07message\x18\x02 \x01(\tR\x07message*Z\n\nUserStatus\x12\x1b\n\x17USER_STATUS_UNSPECIFIED\x10\x00\x12\x16\n\x12USER_STATUS_ACTIVE\x10\x01\x12\x17\n\x13USER_STATUS_PENDING\x10\x02\x32\xb5\x01\n\x0bUserService\x12O\n\nCreateUser\x12\x1e.hello_world.CreateUserRequest\x1a\x1f.hello_world.CreateUserResponse\"\x00\x12U\n\rCreateUserAlt\x12\x1e.hello_world.CreateUserRequest\x1a\".hello_world.CreateUserAltResponse\"\x00\x42\xb4\x01\n\x0f\x63om.hello_worldB\x0fHelloworldProtoP\x01ZHgithub.com/amirsalarsafaei/proto-error-handling/go/helloworld;helloworld\xa2\x02\x03HXX\xaa\x02\nHelloWorld\xca\x02\nHelloWorld\xe2\x02\x16HelloWorld\\GPBMetadata\xea\x02\nHelloWorldb\x06proto3')
+DESCRIPTOR = _descriptor_pool.Default().AddSerializedFile(b'\n\x1bhelloworld/helloworld.proto\x12\x0bhello_world\x1a\x19\x65rrorspec/errorspec.proto\x1a\x19\x66ieldspec/fieldspec.proto\x1a google/protobuf/field_mask.proto\"\x86\x01\n\x11\x43reateUserRequest\x12-\n\x08username\x18\x01 \x01(\tB\x11\xc2\xc1\x18\r\x08\x01\x12\t\x10\x32\x1a\x05^\\S+$R\x08username\x12#\n\x05\x65mail\x18\x02 \x01(\tB\r\xc2\xc1\x18\t\x08\x01\x12\x05\x10\xfe\x01 \x01R\x05\x65mail\x12\x1d\n\nrequest_id\x18\x03 \x01(\tR\trequestId\"^\n\x12\x43reateUserResponse\x12\x17\n\x07user_id\x18\x01 \x01(\tR\x06userId\x12/\n\x06status\x18\x02 \x01(\x0e\x32\x17.hello_world.UserStatusR\x06status\"\x87\x01\n\x15\x43reateUserAltResponse\x12\x31\n\x07success\x18\x01 \x01(\x0b\x32\x15.hello_world.UserDataH\x00R\x07success\x12\x31\n\x05\x65rror\x18\x02 \x01(\x0b\x32\x19.hello_world.ErrorDetailsH\x00R\x05\x65rrorB\x08\n\x06result\"T\n\x08UserData\x12\x17\n\x07user_id\x18\x01 \x01(\tR\x06userId\x12/\n\x06status\x18\x02 \x01(\x0e\x32\x17.hello_world.UserStatusR\x06status\"\x96\x01\n\x04User\x12\x17\n\x07user_id\x18\x01 \x01(\tR\x06userId\x12\x1a\n\x08username\x18\x02 \x01(\tR\x08username\x12\x14\n\x05\x65mail\x18\x03 \x01(\tR\x05\x65mail\x12/\n\x06status\x18\x04 \x01(\x0e\x32\x17.hello_world.UserStatusR\x06status\x12\x12\n\x04\x65tag\x18\x05 \x01(\tR\x04\x65tag\")\n\x0eGetUserRequest\x12\x17\n\x07user_id\x18\x01 \x01(\tR\x06userId\"8\n\x0fGetUserResponse\x12%\n\x04user\x18\x01 \x01(\x0b\x32\x11.hello_world.UserR\x04user\"w\n\x11UpdateUserRequest\x12%\n\x04user\x18\x01 \x01(\x0b\x32\x11.hello_world.UserR\x04user\x12;\n\x0bupdate_mask\x18\x02 \x01(\x0b\x32\x1a.google.protobuf.FieldMaskR\nupdateMask\";\n\x12UpdateUserResponse\x12%\n\x04user\x18\x01 \x01(\x0b\x32\x11.hello_world.UserR\x04user\"@\n\x11\x44\x65leteUserRequest\x12\x17\n\x07user_id\x18\x01 \x01(\tR\x06userId\x12\x12\n\x04\x65tag\x18\x02 \x01(\tR\x04\x65tag\";\n\x12\x44\x65leteUserResponse\x12%\n\x04user\x18\x01 \x01(\x0b\x32\x11.hello_world.UserR\x04user\"\x7f\n\x10ListUsersRequest\x12/\n\x06status\x18\x01 \x01(\x0e\x32\x17.hello_world.UserStatusR\x06status\x12\x1b\n\tpage_size\x18\x02 \x01(\x05R\x08pageSize\x12\x1d\n\npage_token\x18\x03 \x01(\tR\tpageToken\"d\n\x11ListUsersResponse\x12\'\n\x05users\x18\x01 \x03(\x0b\x32\x11.hello_world.UserR\x05users\x12&\n\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\xa4\x01\n\x0c\x45rrorDetails\x12,\n\x04\x63ode\x18\x04 \x01(\x0e\x32\x18.hello_world.ErrorReasonR\x04\x63ode\x12\x18\n\x07message\x18\x02 \x01(\tR\x07message\x12\x46\n\x10\x66ield_violations\x18\x03 \x03(\x0b\x32\x1b.hello_world.FieldViolationR\x0f\x66ieldViolationsJ\x04\x08\x01\x10\x02\"t\n\x0e\x46ieldViolation\x12\x14\n\x05\x66ield\x18\x01 \x01(\tR\x05\x66ield\x12,\n\x04\x63ode\x18\x04 \x01(\x0e\x32\x18.hello_world.ErrorReasonR\x04\x63ode\x12\x18\n\x07message\x18\x03 \x01(\tR\x07messageJ\x04\x08\x02\x10\x03*Z\n\nUserStatus\x12\x1b\n\x17USER_STATUS_UNSPECIFIED\x10\x00\x12\x16\n\x12USER_STATUS_ACTIVE\x10\x01\x12\x17\n\x13USER_STATUS_PENDING\x10\x02*\xf5\x10\n\x0b\x45rrorReason\x12\x1c\n\x18\x45RROR_REASON_UNSPECIFIED\x10\x00\x12\x32\n\x11VALIDATION_FAILED\x10\x01\x1a\x1b\xa2\xbb\x18\x17\x08\x03\x12\x11Invalid user data(\x01\x12I\n\x19VALIDATION_EMPTY_USERNAME\x10\x02\x1a*\xa2\xbb\x18&\x08\x03\x12\x18Username cannot be empty\x1a\x08username\x12S\n\x1cVALIDATION_USERNAME_TOO_LONG\x10\x03\x1a\x31\xa2\xbb\x18-\x08\x03\x12\x1fUsername exceeds maximum length\x1a\x08username\x12U\n\x1dVALIDATION_USERNAME_TOO_SHORT\x10\x18\x1a\x32\xa2\xbb\x18.\x08\x03\x12 Username is below minimum length\x1a\x08username\x12W\n\x1bVALIDATION_INVALID_USERNAME\x10\x19\x1a\x36\xa2\xbb\x18\x32\x08\x03\x12$Username contains invalid characters\x1a\x08username\x12J\n\x1bVALIDATION_USERNAME_BLOCKED\x10\x1a\x1a)\xa2\xbb\x18%\x08\x03\x12\x17Username is not allowed\x1a\x08username\x12@\n\x16VALIDATION_EMPTY_EMAIL\x10\x04\x1a$\xa2\xbb\x18 \x08\x03\x12\x15\x45mail cannot be empty\x1a\x05\x65mail\x12\x41\n\x18VALIDATION_INVALID_EMAIL\x10\x05\x1a#\xa2\xbb\x18\x1f\x08\x03\x12\x14Invalid email format\x1a\x05\x65mail\x12S\n#VALIDATION_EMAIL_DOMAIN_NOT_ALLOWED\x10\x1b\x1a*\xa2\xbb\x18&\x08\x03\x12\x1b\x45mail domain is not allowed\x1a\x05\x65mail\x12J\n\x19VALIDATION_EMAIL_TOO_LONG\x10\x1c\x1a+\xa2\xbb\x18\'\x08\x03\x12\x1c\x45mail exceeds maximum length\x1a\x05\x65mail\x12^\n\x1eVALIDATION_CONSTRAINT_VIOLATED\x10\x1d\x1a:\xa2\xbb\x18\x36\x08\x03\x12&Value violates a constraint of the API\"\nconstraint\x12?\n\x0f\x44UPLICATE_EMAIL\x10\x06\x1a*\xa2\xbb\x18&\x08\x06\x12\x14\x45mail already in use\x1a\x05\x65mail\"\x05\x65mail\x12K\n\x12\x44UPLICATE_USERNAME\x10\x07\x1a\x33\xa2\xbb\x18/\x08\x06\x12\x17Username already in use\x1a\x08username\"\x08username\x12*\n\x0eUSER_NOT_FOUND\x10\x08\x1a\x16\xa2\xbb\x18\x12\x08\x05\x12\x0eUser not found\x12j\n\x11REQUEST_ID_REUSED\x10\t\x1aS\xa2\xbb\x18O\x08\x03\x12\x33Request ID was already used for a different request\x1a\nrequest_id\"\nrequest_id\x12\x45\n\x0fINVALID_USER_ID\x10\n\x1a\x30\xa2\xbb\x18,\x08\x03\x12\x16User ID must be a UUID\x1a\x07user_id\"\x07user_id\x12\x44\n\x13INVALID_USER_STATUS\x10\x0b\x1a+\xa2\xbb\x18\'\x08\x03\x12\x13Invalid user status\x1a\x06status\"\x06status\x12V\n\x11\x45MPTY_UPDATE_MASK\x10\x0c\x1a?\xa2\xbb\x18;\x08\x03\x12*Update mask must list the fields to update\x1a\x0bupdate_mask\x12i\n\x18INVALID_UPDATE_MASK_PATH\x10\r\x1aK\xa2\xbb\x18G\x08\x03\x12\x30Update mask lists a field that cannot be updated\x1a\x0bupdate_mask\"\x04path\x12S\n\x11INVALID_PAGE_SIZE\x10\x0e\x1a<\xa2\xbb\x18\x38\x08\x03\x12\x1ePage size must not be negative\x1a\tpage_size\"\tpage_size\x12@\n\x12INVALID_PAGE_TOKEN\x10\x0f\x1a(\xa2\xbb\x18$\x08\x03\x12\x12Invalid page token\x1a\npage_token0\x01\x12\x44\n\x12\x45XPIRED_PAGE_TOKEN\x10\x10\x1a,\xa2\xbb\x18(\x08\x03\x12\x16Page token has expired\x1a\npage_token0\x01\x12\x32\n\x0cINVALID_ETAG\x10\x11\x1a \xa2\xbb\x18\x1c\x08\x03\x12\x0cInvalid etag\x1a\x04\x65tag\"\x04\x65tag\x12\x38\n\nSTALE_ETAG\x10\x12\x1a(\xa2\xbb\x18$\x08\n\x12\x1eUser was modified concurrently0\x01\x12@\n\x0bUSER_ACTIVE\x10\x13\x1a/\xa2\xbb\x18+\x08\t\x12\x1e\x41\x63tive users cannot be deleted\"\x07user_id\x12:\n\x11\x44\x45\x41\x44LINE_EXCEEDED\x10\x14\x1a#\xa2\xbb\x18\x1f\x08\x04\x12\x19Request deadline exceeded0\x01\x12\x30\n\x10REQUEST_CANCELED\x10\x15\x1a\x1a\xa2\xbb\x18\x16\x08\x01\x12\x10Request canceled0\x01\x12\x36\n\x13RATE_LIMIT_EXCEEDED\x10\x16\x1a\x1d\xa2\xbb\x18\x19\x08\x08\x12\x13Rate limit exceeded0\x01\x12,\n\x0eINTERNAL_ERROR\x10\x17\x1a\x18\xa2\xbb\x18\x14\x08\r\x12\x0eInternal error0\x01\x1a\x1b\xa2\xbb\x18\x17hello_world.UserService2\xed\x03\n\x0bUserService\x12O\n\nCreateUser\x12\x1e.hello_world.CreateUserRequest\x1a\x1f.hello_world.CreateUserResponse\"\x00\x12U\n\rCreateUserAlt\x12\x1e.hello_world.CreateUserRequest\x1a\".hello_world.CreateUserAltResponse\"\x00\x12\x46\n\x07GetUser\x12\x1b.hello_world.GetUserRequest\x1a\x1c.hello_world.GetUserResponse\"\x00\x12O\n\nUpdateUser\x12\x1e.hello_world.UpdateUserRequest\x1a\x1f.hello_world.UpdateUserResponse\"\x00\x12O\n\nDeleteUser\x12\x1e.hello_world.DeleteUserRequest\x1a\x1f.hello_world.DeleteUserResponse\"\x00\x12L\n\tListUsers\x12\x1d.hello_world.ListUsersRequest\x1a\x1e.hello_world.ListUsersResponse\"\x00\x42\xc2\x01\n\x0f\x63om.hello_worldB\x0fHelloworldProtoP\x01ZVgithub.com/amirsalarsafaei/proto-error-handling/autogenerated/go/helloworld;helloworld\xa2\x02\x03HXX\xaa\x02\nHelloWorld\xca\x02\nHelloWorld\xe2\x02\x16HelloWorld\\GPBMetadata\xea\x02\nHelloWorldb\x06proto3')
 
 _globals = globals()
 _builder.BuildMessageAndEnumDescriptors(DESCRIPTOR, _globals)
 _builder.BuildTopDescriptorsAndMessages(DESCRIPTOR, 'helloworld.helloworld_pb2', _globals)
 if not _descriptor._USE_C_DESCRIPTORS:
   _globals['DESCRIPTOR']._loaded_options = None
-  _globals['DESCRIPTOR']._serialized_options = b'\n\017com.hello_worldB\017HelloworldProtoP\001ZHgithub.com/amirsalarsafaei/proto-error-handling/go/helloworld;helloworld\242\002\003HXX\252\002\nHelloWorld\312\002\nHelloWorld\342\002\026HelloWorld\\GPBMetadata\352\002\nHelloWorld'
-  _globals['_USERSTATUS']._serialized_start=497
-  _globals['_USERSTATUS']._serialized_end=587
-  _globals['_CREATEUSERREQUEST']._serialized_start=44
-  _globals['_CREATEUSERREQUEST']._serialized_end=113
-  _globals['_CREATEUSERRESPONSE']._serialized_start=115
-  _globals['_CREATEUSERRESPONSE']._serialized_end=209
-  _globals['_CREATEUSERALTRESPONSE']._serialized_start=212
-  _globals['_CREATEUSERALTRESPONSE']._serialized_end=347
-  _globals['_USERDATA']._serialized_start=349
-  _globals['_USERDATA']._serialized_end=433
-  _globals['_ERRORDETAILS']._serialized_start=435
-  _globals['_ERRORDETAILS']._serialized_end=495
-  _globals['_USERSERVICE']._serialized_start=590
-  _globals['_USERSERVICE']._serialized_end=771
+  _globals['DESCRIPTOR']._serialized_options = b'\n\017com.hello_worldB\017HelloworldProtoP\001ZVgithub.com/amirsalarsafaei/proto-error-handling/autogenerated/go/helloworld;helloworld\242\002\003HXX\252\002\nHelloWorld\312\002\nHelloWorld\342\002\026HelloWorld\\GPBMetadata\352\002\nHelloWorld'
+  _globals['_ERRORREASON']._loaded_options = None
+  _globals['_ERRORREASON']._serialized_options = b'\242\273\030\027hello_world.UserService'
+  _globals['_ERRORREASON'].values_by_name["VALIDATION_FAILED"]._loaded_options = None
+  _globals['_ERRORREASON'].values_by_name["VALIDATION_FAILED"]._serialized_options = b'\242\273\030\027\010\003\022\021Invalid user data(\001'
+  _globals['_ERRORREASON'].values_by_name["VALIDATION_EMPTY_USERNAME"]._loaded_options = None
+  _globals['_ERRORREASON'].values_by_name["VALIDATION_EMPTY_USERNAME"]._serialized_options = b'\242\273\030&\010\003\022\030Username cannot be empty\032\010username'
+  _globals['_ERRORREASON'].values_by_name["VALIDATION_USERNAME_TOO_LONG"]._loaded_options = None
+  _globals['_ERRORREASON'].values_by_name["VALIDATION_USERNAME_TOO_LONG"]._serialized_options = b'\242\273\030-\010\003\022\037Username exceeds maximum length\032\010username'
+  _globals['_ERRORREASON'].values_by_name["VALIDATION_USERNAME_TOO_SHORT"]._loaded_options = None
+  _globals['_ERRORREASON'].values_by_name["VALIDATION_USERNAME_TOO_SHORT"]._serialized_options = b'\242\273\030.\010\003\022 Username is below minimum length\032\010username'
+  _globals['_ERRORREASON'].values_by_name["VALIDATION_INVALID_USERNAME"]._loaded_options = None
+  _globals['_ERRORREASON'].values_by_name["VALIDATION_INVALID_USERNAME"]._serialized_options = b'\242\273\0302\010\003\022$Username contains invalid characters\032\010username'
+  _globals['_ERRORREASON'].values_by_name["VALIDATION_USERNAME_BLOCKED"]._loaded_options = None
+  _globals['_ERRORREASON'].values_by_name["VALIDATION_USERNAME_BLOCKED"]._serialized_options = b'\242\273\030%\010\003\022\027Username is not allowed\032\010username'
+  _globals['_ERRORREASON'].values_by_name["VALIDATION_EMPTY_EMAIL"]._loaded_options = None
+  _globals['_ERRORREASON'].values_by_name["VALIDATION_EMPTY_EMAIL"]._serialized_options = b'\242\273\030 \010\003\022\025Email cannot be empty\032\005email'
+  _globals['_ERRORREASON'].values_by_name["VALIDATION_INVALID_EMAIL"]._loaded_options = None
+  _globals['_ERRORREASON'].values_by_name["VALIDATION_INVALID_EMAIL"]._serialized_options = b'\242\273\030\037\010\003\022\024Invalid email format\032\005email'
+  _globals['_ERRORREASON'].values_by_name["VALIDATION_EMAIL_DOMAIN_NOT_ALLOWED"]._loaded_options = None
+  _globals['_ERRORREASON'].values_by_name["VALIDATION_EMAIL_DOMAIN_NOT_ALLOWED"]._serialized_options = b'\242\273\030&\010\003\022\033Email domain is not allowed\032\005email'
+  _globals['_ERRORREASON'].values_by_name["VALIDATION_EMAIL_TOO_LONG"]._loaded_options = None
+  _globals['_ERRORREASON'].values_by_name["VALIDATION_EMAIL_TOO_LONG"]._serialized_options = b'\242\273\030\'\010\003\022\034Email exceeds maximum length\032\005email'
+  _globals['_ERRORREASON'].values_by_name["VALIDATION_CONSTRAINT_VIOLATED"]._loaded_options = None
+  _globals['_ERRORREASON'].values_by_name["VALIDATION_CONSTRAINT_VIOLATED"]._serialized_options = b'\242\273\0306\010\003\022&Value violates a constraint of the API\"\nconstraint'
+  _globals['_ERRORREASON'].values_by_name["DUPLICATE_EMAIL"]._loaded_options = None
+  _globals['_ERRORREASON'].values_by_name["DUPLICATE_EMAIL"]._serialized_options = b'\242\273\030&\010\006\022\024Email already in use\032\005email\"\005email'
+  _globals['_ERRORREASON'].values_by_name["DUPLICATE_USERNAME"]._loaded_options = None
+  _globals['_ERRORREASON'].values_by_name["DUPLICATE_USERNAME"]._serialized_options = b'\242\273\030/\010\006\022\027Username already in use\032\010username\"\010username'
+  _globals['_ERRORREASON'].values_by_name["USER_NOT_FOUND"]._loaded_options = None
+  _globals['_ERRORREASON'].values_by_name["USER_NOT_FOUND"]._serialized_options = b'\242\273\030\022\010\005\022\016User not found'
+  _globals['_ERRORREASON'].values_by_name["REQUEST_ID_REUSED"]._loaded_options = None
+  _globals['_ERRORREASON'].values_by_name["REQUEST_ID_REUSED"]._serialized_options = b'\242\273\030O\010\003\0223Request ID was already used for a different request\032\nrequest_id\"\nrequest_id'
+  _globals['_ERRORREASON'].values_by_name["INVALID_USER_ID"]._loaded_options = None
+  _globals['_ERRORREASON'].values_by_name["INVALID_USER_ID"]._serialized_options = b'\242\273\030,\010\003\022\026User ID must be a UUID\032\007user_id\"\007user_id'
+  _globals['_ERRORREASON'].values_by_name["INVALID_USER_STATUS"]._loaded_options = None
+  _globals['_ERRORREASON'].values_by_name["INVALID_USER_STATUS"]._serialized_options = b'\242\273\030\'\010\003\022\023Invalid user status\032\006status\"\006status'
+  _globals['_ERRORREASON'].values_by_name["EMPTY_UPDATE_MASK"]._loaded_options = None
+  _globals['_ERRORREASON'].values_by_name["EMPTY_UPDATE_MASK"]._serialized_options = b'\242\273\030;\010\003\022*Update mask must list the fields to update\032\013update_mask'
+  _globals['_ERRORREASON'].values_by_name["INVALID_UPDATE_MASK_PATH"]._loaded_options = None
+  _globals['_ERRORREASON'].values_by_name["INVALID_UPDATE_MASK_PATH"]._serialized_options = b'\242\273\030G\010\003\0220Update mask lists a field that cannot be updated\032\013update_mask\"\004path'
+  _globals['_ERRORREASON'].values_by_name["INVALID_PAGE_SIZE"]._loaded_options = None
+  _globals['_ERRORREASON'].values_by_name["INVALID_PAGE_SIZE"]._serialized_options = b'\242\273\0308\010\003\022\036Page size must not be negative\032\tpage_size\"\tpage_size'
+  _globals['_ERRORREASON'].values_by_name["INVALID_PAGE_TOKEN"]._loaded_options = None
+  _globals['_ERRORREASON'].values_by_name["INVALID_PAGE_TOKEN"]._serialized_options = b'\242\273\030$\010\003\022\022Invalid page token\032\npage_token0\001'
+  _globals['_ERRORREASON'].values_by_name["EXPIRED_PAGE_TOKEN"]._loaded_options = None
+  _globals['_ERRORREASON'].values_by_name["EXPIRED_PAGE_TOKEN"]._serialized_options = b'\242\273\030(\010\003\022\026Page token has expired\032\npage_token0\001'
+  _globals['_ERRORREASON'].values_by_name["INVALID_ETAG"]._loaded_options = None
+  _globals['_ERRORREASON'].values_by_name["INVALID_ETAG"]._serialized_options = b'\242\273\030\034\010\003\022\014Invalid etag\032\004etag\"\004etag'
+  _globals['_ERRORREASON'].values_by_name["STALE_ETAG"]._loaded_options = None
+  _globals['_ERRORREASON'].values_by_name["STALE_ETAG"]._serialized_options = b'\242\273\030$\010\n\022\036User was modified concurrently0\001'
+  _globals['_ERRORREASON'].values_by_name["USER_ACTIVE"]._loaded_options = None
+  _globals['_ERRORREASON'].values_by_name["USER_ACTIVE"]._serialized_options = b'\242\273\030+\010\t\022\036Active users cannot be deleted\"\007user_id'
+  _globals['_ERRORREASON'].values_by_name["DEADLINE_EXCEEDED"]._loaded_options = None
+  _globals['_ERRORREASON'].values_by_name["DEADLINE_EXCEEDED"]._serialized_options = b'\242\273\030\037\010\004\022\031Request deadline exceeded0\001'
+  _globals['_ERRORREASON'].values_by_name["REQUEST_CANCELED"]._loaded_options = None
+  _globals['_ERRORREASON'].values_by_name["REQUEST_CANCELED"]._serialized_options = b'\242\273\030\026\010\001\022\020Request canceled0\001'
+  _globals['_ERRORREASON'].values_by_name["RATE_LIMIT_EXCEEDED"]._loaded_options = None
+  _globals['_ERRORREASON'].values_by_name["RATE_LIMIT_EXCEEDED"]._serialized_options = b'\242\273\030\031\010\010\022\023Rate limit exceeded0\001'
+  _globals['_ERRORREASON'].values_by_name["INTERNAL_ERROR"]._loaded_options = None
+  _globals['_ERRORREASON'].values_by_name["INTERNAL_ERROR"]._serialized_options = b'\242\273\030\024\010\r\022\016Internal error0\001'
+  _globals['_CREATEUSERREQUEST'].fields_by_name['username']._loaded_options = None
+  _globals['_CREATEUSERREQUEST'].fields_by_name['username']._serialized_options = b'\302\301\030\r\010\001\022\t\0202\032\005^\\S+$'
+  _globals['_CREATEUSERREQUEST'].fields_by_name['email']._loaded_options = None
+  _globals['_CREATEUSERREQUEST'].fields_by_name['email']._serialized_options = b'\302\301\030\t\010\001\022\005\020\376\001 \001'
+  _globals['_USERSTATUS']._serialized_start=1668
+  _globals['_USERSTATUS']._serialized_end=1758
+  _globals['_ERRORREASON']._serialized_start=1761
+  _globals['_ERRORREASON']._serialized_end=3926
+  _globals['_CREATEUSERREQUEST']._serialized_start=133
+  _globals['_CREATEUSERREQUEST']._serialized_end=267
+  _globals['_CREATEUSERRESPONSE']._serialized_start=269
+  _globals['_CREATEUSERRESPONSE']._serialized_end=363
+  _globals['_CREATEUSERALTRESPONSE']._serialized_start=366
+  _globals['_CREATEUSERALTRESPONSE']._serialized_end=501
+  _globals['_USERDATA']._serialized_start=503
+  _globals['_USERDATA']._serialized_end=587
+  _globals['_USER']._serialized_start=590
+  _globals['_USER']._serialized_end=740
+  _globals['_GETUSERREQUEST']._serialized_start=742
+  _globals['_GETUSERREQUEST']._serialized_end=783
+  _globals['_GETUSERRESPONSE']._serialized_start=785
+  _globals['_GETUSERRESPONSE']._serialized_end=841
+  _globals['_UPDATEUSERREQUEST']._serialized_start=843
+  _globals['_UPDATEUSERREQUEST']._serialized_end=962
+  _globals['_UPDATEUSERRESPONSE']._serialized_start=964
+  _globals['_UPDATEUSERRESPONSE']._serialized_end=1023
+  _globals['_DELETEUSERREQUEST']._serialized_start=1025
+  _globals['_DELETEUSERREQUEST']._serialized_end=1089
+  _globals['_DELETEUSERRESPONSE']._serialized_start=1091
+  _globals['_DELETEUSERRESPONSE']._serialized_end=1150
+  _globals['_LISTUSERSREQUEST']._serialized_start=1152
+  _globals['_LISTUSERSREQUEST']._serialized_end=1279
+  _globals['_LISTUSERSRESPONSE']._serialized_start=1281
+  _globals['_LISTUSERSRESPONSE']._serialized_end=1381
+  _globals['_ERRORDETAILS']._serialized_start=1384
+  _globals['_ERRORDETAILS']._serialized_end=1548
+  _globals['_FIELDVIOLATION']._serialized_start=1550
+  _globals['_FIELDVIOLATION']._serialized_end=1666
+  _globals['_USERSERVICE']._serialized_start=3929
+  _globals['_USERSERVICE']._serialized_end=4422
 # @@protoc_insertion_point(module_scope)
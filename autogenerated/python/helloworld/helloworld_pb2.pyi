@@ -1,7 +1,11 @@
+from errorspec import errorspec_pb2 as _errorspec_pb2
+from fieldspec import fieldspec_pb2 as _fieldspec_pb2
+from google.protobuf import field_mask_pb2 as _field_mask_pb2
+from google.protobuf.internal import containers as _containers
 from google.protobuf.internal import enum_type_wrapper as _enum_type_wrapper
 from google.protobuf import descriptor as _descriptor
 from google.protobuf import message as _message
-from typing import ClassVar as _ClassVar, Mapping as _Mapping, Optional as _Optional, Union as _Union
+from typing import ClassVar as _ClassVar, Iterable as _Iterable, Mapping as _Mapping, Optional as _Optional, Union as _Union
 
 DESCRIPTOR: _descriptor.FileDescriptor
 
@@ -10,17 +14,82 @@ class UserStatus(int, metaclass=_enum_type_wrapper.EnumTypeWrapper):
     USER_STATUS_UNSPECIFIED: _ClassVar[UserStatus]
     USER_STATUS_ACTIVE: _ClassVar[UserStatus]
     USER_STATUS_PENDING: _ClassVar[UserStatus]
+
+class ErrorReason(int, metaclass=_enum_type_wrapper.EnumTypeWrapper):
+    __slots__ = ()
+    ERROR_REASON_UNSPECIFIED: _ClassVar[ErrorReason]
+    VALIDATION_FAILED: _ClassVar[ErrorReason]
+    VALIDATION_EMPTY_USERNAME: _ClassVar[ErrorReason]
+    VALIDATION_USERNAME_TOO_LONG: _ClassVar[ErrorReason]
+    VALIDATION_USERNAME_TOO_SHORT: _ClassVar[ErrorReason]
+    VALIDATION_INVALID_USERNAME: _ClassVar[ErrorReason]
+    VALIDATION_USERNAME_BLOCKED: _ClassVar[ErrorReason]
+    VALIDATION_EMPTY_EMAIL: _ClassVar[ErrorReason]
+    VALIDATION_INVALID_EMAIL: _ClassVar[ErrorReason]
+    VALIDATION_EMAIL_DOMAIN_NOT_ALLOWED: _ClassVar[ErrorReason]
+    VALIDATION_EMAIL_TOO_LONG: _ClassVar[ErrorReason]
+    VALIDATION_CONSTRAINT_VIOLATED: _ClassVar[ErrorReason]
+    DUPLICATE_EMAIL: _ClassVar[ErrorReason]
+    DUPLICATE_USERNAME: _ClassVar[ErrorReason]
+    USER_NOT_FOUND: _ClassVar[ErrorReason]
+    REQUEST_ID_REUSED: _ClassVar[ErrorReason]
+    INVALID_USER_ID: _ClassVar[ErrorReason]
+    INVALID_USER_STATUS: _ClassVar[ErrorReason]
+    EMPTY_UPDATE_MASK: _ClassVar[ErrorReason]
+    INVALID_UPDATE_MASK_PATH: _ClassVar[ErrorReason]
+    INVALID_PAGE_SIZE: _ClassVar[ErrorReason]
+    INVALID_PAGE_TOKEN: _ClassVar[ErrorReason]
+    EXPIRED_PAGE_TOKEN: _ClassVar[ErrorReason]
+    INVALID_ETAG: _ClassVar[ErrorReason]
+    STALE_ETAG: _ClassVar[ErrorReason]
+    USER_ACTIVE: _ClassVar[ErrorReason]
+    DEADLINE_EXCEEDED: _ClassVar[ErrorReason]
+    REQUEST_CANCELED: _ClassVar[ErrorReason]
+    RATE_LIMIT_EXCEEDED: _ClassVar[ErrorReason]
+    INTERNAL_ERROR: _ClassVar[ErrorReason]
 USER_STATUS_UNSPECIFIED: UserStatus
 USER_STATUS_ACTIVE: UserStatus
 USER_STATUS_PENDING: UserStatus
+ERROR_REASON_UNSPECIFIED: ErrorReason
+VALIDATION_FAILED: ErrorReason
+VALIDATION_EMPTY_USERNAME: ErrorReason
+VALIDATION_USERNAME_TOO_LONG: ErrorReason
+VALIDATION_USERNAME_TOO_SHORT: ErrorReason
+VALIDATION_INVALID_USERNAME: ErrorReason
+VALIDATION_USERNAME_BLOCKED: ErrorReason
+VALIDATION_EMPTY_EMAIL: ErrorReason
+VALIDATION_INVALID_EMAIL: ErrorReason
+VALIDATION_EMAIL_DOMAIN_NOT_ALLOWED: ErrorReason
+VALIDATION_EMAIL_TOO_LONG: ErrorReason
+VALIDATION_CONSTRAINT_VIOLATED: ErrorReason
+DUPLICATE_EMAIL: ErrorReason
+DUPLICATE_USERNAME: ErrorReason
+USER_NOT_FOUND: ErrorReason
+REQUEST_ID_REUSED: ErrorReason
+INVALID_USER_ID: ErrorReason
+INVALID_USER_STATUS: ErrorReason
+EMPTY_UPDATE_MASK: ErrorReason
+INVALID_UPDATE_MASK_PATH: ErrorReason
+INVALID_PAGE_SIZE: ErrorReason
+INVALID_PAGE_TOKEN: ErrorReason
+EXPIRED_PAGE_TOKEN: ErrorReason
+INVALID_ETAG: ErrorReason
+STALE_ETAG: ErrorReason
+USER_ACTIVE: ErrorReason
+DEADLINE_EXCEEDED: ErrorReason
+REQUEST_CANCELED: ErrorReason
+RATE_LIMIT_EXCEEDED: ErrorReason
+INTERNAL_ERROR: ErrorReason
 
 class CreateUserRequest(_message.Message):
-    __slots__ = ("username", "email")
+    __slots__ = ("username", "email", "request_id")
     USERNAME_FIELD_NUMBER: _ClassVar[int]
     EMAIL_FIELD_NUMBER: _ClassVar[int]
+    REQUEST_ID_FIELD_NUMBER: _ClassVar[int]
     username: str
     email: str
-    def __init__(self, username: _Optional[str] = ..., email: _Optional[str] = ...) -> None: ...
+    request_id: str
+    def __init__(self, username: _Optional[str] = ..., email: _Optional[str] = ..., request_id: _Optional[str] = ...) -> None: ...
 
 class CreateUserResponse(_message.Message):
     __slots__ = ("user_id", "status")
@@ -46,10 +115,94 @@ class UserData(_message.Message):
     status: UserStatus
     def __init__(self, user_id: _Optional[str] = ..., status: _Optional[_Union[UserStatus, str]] = ...) -> None: ...
 
+class User(_message.Message):
+    __slots__ = ("user_id", "username", "email", "status", "etag")
+    USER_ID_FIELD_NUMBER: _ClassVar[int]
+    USERNAME_FIELD_NUMBER: _ClassVar[int]
+    EMAIL_FIELD_NUMBER: _ClassVar[int]
+    STATUS_FIELD_NUMBER: _ClassVar[int]
+    ETAG_FIELD_NUMBER: _ClassVar[int]
+    user_id: str
+    username: str
+    email: str
+    status: UserStatus
+    etag: str
+    def __init__(self, user_id: _Optional[str] = ..., username: _Optional[str] = ..., email: _Optional[str] = ..., status: _Optional[_Union[UserStatus, str]] = ..., etag: _Optional[str] = ...) -> None: ...
+
+class GetUserRequest(_message.Message):
+    __slots__ = ("user_id",)
+    USER_ID_FIELD_NUMBER: _ClassVar[int]
+    user_id: str
+    def __init__(self, user_id: _Optional[str] = ...) -> None: ...
+
+class GetUserResponse(_message.Message):
+    __slots__ = ("user",)
+    USER_FIELD_NUMBER: _ClassVar[int]
+    user: User
+    def __init__(self, user: _Optional[_Union[User, _Mapping]] = ...) -> None: ...
+
+class UpdateUserRequest(_message.Message):
+    __slots__ = ("user", "update_mask")
+    USER_FIELD_NUMBER: _ClassVar[int]
+    UPDATE_MASK_FIELD_NUMBER: _ClassVar[int]
+    user: User
+    update_mask: _field_mask_pb2.FieldMask
+    def __init__(self, user: _Optional[_Union[User, _Mapping]] = ..., update_mask: _Optional[_Union[_field_mask_pb2.FieldMask, _Mapping]] = ...) -> None: ...
+
+class UpdateUserResponse(_message.Message):
+    __slots__ = ("user",)
+    USER_FIELD_NUMBER: _ClassVar[int]
+    user: User
+    def __init__(self, user: _Optional[_Union[User, _Mapping]] = ...) -> None: ...
+
+class DeleteUserRequest(_message.Message):
+    __slots__ = ("user_id", "etag")
+    USER_ID_FIELD_NUMBER: _ClassVar[int]
+    ETAG_FIELD_NUMBER: _ClassVar[int]
+    user_id: str
+    etag: str
+    def __init__(self, user_id: _Optional[str] = ..., etag: _Optional[str] = ...) -> None: ...
+
+class DeleteUserResponse(_message.Message):
+    __slots__ = ("user",)
+    USER_FIELD_NUMBER: _ClassVar[int]
+    user: User
+    def __init__(self, user: _Optional[_Union[User, _Mapping]] = ...) -> None: ...
+
+class ListUsersRequest(_message.Message):
+    __slots__ = ("status", "page_size", "page_token")
+    STATUS_FIELD_NUMBER: _ClassVar[int]
+    PAGE_SIZE_FIELD_NUMBER: _ClassVar[int]
+    PAGE_TOKEN_FIELD_NUMBER: _ClassVar[int]
+    status: UserStatus
+    page_size: int
+    page_token: str
+    def __init__(self, status: _Optional[_Union[UserStatus, str]] = ..., page_size: _Optional[int] = ..., page_token: _Optional[str] = ...) -> None: ...
+
+class ListUsersResponse(_message.Message):
+    __slots__ = ("users", "next_page_token")
+    USERS_FIELD_NUMBER: _ClassVar[int]
+    NEXT_PAGE_TOKEN_FIELD_NUMBER: _ClassVar[int]
+    users: _containers.RepeatedCompositeFieldContainer[User]
+    next_page_token: str
+    def __init__(self, users: _Optional[_Iterable[_Union[User, _Mapping]]] = ..., next_page_token: _Optional[str] = ...) -> None: ...
+
 class ErrorDetails(_message.Message):
-    __slots__ = ("code", "message")
+    __slots__ = ("code", "message", "field_violations")
+    CODE_FIELD_NUMBER: _ClassVar[int]
+    MESSAGE_FIELD_NUMBER: _ClassVar[int]
+    FIELD_VIOLATIONS_FIELD_NUMBER: _ClassVar[int]
+    code: ErrorReason
+    message: str
+    field_violations: _containers.RepeatedCompositeFieldContainer[FieldViolation]
+    def __init__(self, code: _Optional[_Union[ErrorReason, str]] = ..., message: _Optional[str] = ..., field_violations: _Optional[_Iterable[_Union[FieldViolation, _Mapping]]] = ...) -> None: ...
+
+class FieldViolation(_message.Message):
+    __slots__ = ("field", "code", "message")
+    FIELD_FIELD_NUMBER: _ClassVar[int]
     CODE_FIELD_NUMBER: _ClassVar[int]
     MESSAGE_FIELD_NUMBER: _ClassVar[int]
-    code: str
+    field: str
+    code: ErrorReason
     message: str
-    def __init__(self, code: _Optional[str] = ..., message: _Optional[str] = ...) -> None: ...
+    def __init__(self, field: _Optional[str] = ..., code: _Optional[_Union[ErrorReason, str]] = ..., message: _Optional[str] = ...) -> None: ...
@@ -24,6 +24,26 @@ class UserServiceStub(object):
                 request_serializer=helloworld_dot_helloworld__pb2.CreateUserRequest.SerializeToString,
                 response_deserializer=helloworld_dot_helloworld__pb2.CreateUserAltResponse.FromString,
                 _registered_method=True)
+        self.GetUser = channel.unary_unary(
+                '/hello_world.UserService/GetUser',
+                request_serializer=helloworld_dot_helloworld__pb2.GetUserRequest.SerializeToString,
+                response_deserializer=helloworld_dot_helloworld__pb2.GetUserResponse.FromString,
+                _registered_method=True)
+        self.UpdateUser = channel.unary_unary(
+                '/hello_world.UserService/UpdateUser',
+                request_serializer=helloworld_dot_helloworld__pb2.UpdateUserRequest.SerializeToString,
+                response_deserializer=helloworld_dot_helloworld__pb2.UpdateUserResponse.FromString,
+                _registered_method=True)
+        self.DeleteUser = channel.unary_unary(
+                '/hello_world.UserService/DeleteUser',
+                request_serializer=helloworld_dot_helloworld__pb2.DeleteUserRequest.SerializeToString,
+                response_deserializer=helloworld_dot_helloworld__pb2.DeleteUserResponse.FromString,
+                _registered_method=True)
+        self.ListUsers = channel.unary_unary(
+                '/hello_world.UserService/ListUsers',
+                request_serializer=helloworld_dot_helloworld__pb2.ListUsersRequest.SerializeToString,
+                response_deserializer=helloworld_dot_helloworld__pb2.ListUsersResponse.FromString,
+                _registered_method=True)
 
 
 class UserServiceServicer(object):
@@ -41,6 +61,30 @@ class UserServiceServicer(object):
         context.set_details('Method not implemented!')
         raise NotImplementedError('Method not implemented!')
 
+    def GetUser(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def UpdateUser(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def DeleteUser(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def ListUsers(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
 
 def add_UserServiceServicer_to_server(servicer, server):
     rpc_method_handlers = {
@@ -54,6 +98,26 @@ def add_UserServiceServicer_to_server(servicer, server):
                     request_deserializer=helloworld_dot_helloworld__pb2.CreateUserRequest.FromString,
                     response_serializer=helloworld_dot_helloworld__pb2.CreateUserAltResponse.SerializeToString,
             ),
+            'GetUser': grpc.unary_unary_rpc_method_handler(
+                    servicer.GetUser,
+                    request_deserializer=helloworld_dot_helloworld__pb2.GetUserRequest.FromString,
+                    response_serializer=helloworld_dot_helloworld__pb2.GetUserResponse.SerializeToString,
+            ),
+            'UpdateUser': grpc.unary_unary_rpc_method_handler(
+                    servicer.UpdateUser,
+                    request_deserializer=helloworld_dot_helloworld__pb2.UpdateUserRequest.FromString,
+                    response_serializer=helloworld_dot_helloworld__pb2.UpdateUserResponse.SerializeToString,
+            ),
+            'DeleteUser': grpc.unary_unary_rpc_method_handler(
+                    servicer.DeleteUser,
+                    request_deserializer=helloworld_dot_helloworld__pb2.DeleteUserRequest.FromString,
+                    response_serializer=helloworld_dot_helloworld__pb2.DeleteUserResponse.SerializeToString,
+            ),
+            'ListUsers': grpc.unary_unary_rpc_method_handler(
+                    servicer.ListUsers,
+                    request_deserializer=helloworld_dot_helloworld__pb2.ListUsersRequest.FromString,
+                    response_serializer=helloworld_dot_helloworld__pb2.ListUsersResponse.SerializeToString,
+            ),
     }
     generic_handler = grpc.method_handlers_generic_handler(
             'hello_world.UserService', rpc_method_handlers)
@@ -118,3 +182,111 @@ class UserService(object):
             timeout,
             metadata,
             _registered_method=True)
+
+    @staticmethod
+    def GetUser(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(
+            request,
+            target,
+            '/hello_world.UserService/GetUser',
+            helloworld_dot_helloworld__pb2.GetUserRequest.SerializeToString,
+            helloworld_dot_helloworld__pb2.GetUserResponse.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True)
+
+    @staticmethod
+    def UpdateUser(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(
+            request,
+            target,
+            '/hello_world.UserService/UpdateUser',
+            helloworld_dot_helloworld__pb2.UpdateUserRequest.SerializeToString,
+            helloworld_dot_helloworld__pb2.UpdateUserResponse.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True)
+
+    @staticmethod
+    def DeleteUser(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(
+            request,
+            target,
+            '/hello_world.UserService/DeleteUser',
+            helloworld_dot_helloworld__pb2.DeleteUserRequest.SerializeToString,
+            helloworld_dot_helloworld__pb2.DeleteUserResponse.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True)
+
+    @staticmethod
+    def ListUsers(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(
+            request,
+            target,
+            '/hello_world.UserService/ListUsers',
+            helloworld_dot_helloworld__pb2.ListUsersRequest.SerializeToString,
+            helloworld_dot_helloworld__pb2.ListUsersResponse.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True)
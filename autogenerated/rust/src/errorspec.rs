@@ -0,0 +1,236 @@
+// @generated
+// This file is @generated by prost-build.
+#[allow(clippy::derive_partial_eq_without_eq)]
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct ErrorSpec {
+    #[prost(enumeration="Code", tag="1")]
+    pub code: i32,
+    #[prost(string, tag="2")]
+    pub message: ::prost::alloc::string::String,
+    #[prost(string, tag="3")]
+    pub field: ::prost::alloc::string::String,
+    #[prost(string, repeated, tag="4")]
+    pub metadata: ::prost::alloc::vec::Vec<::prost::alloc::string::String>,
+    #[prost(bool, tag="5")]
+    pub aggregate: bool,
+    #[prost(bool, tag="6")]
+    pub external: bool,
+}
+#[derive(Clone, Copy, Debug, PartialEq, Eq, Hash, PartialOrd, Ord, ::prost::Enumeration)]
+#[repr(i32)]
+pub enum Code {
+    Ok = 0,
+    Cancelled = 1,
+    Unknown = 2,
+    InvalidArgument = 3,
+    DeadlineExceeded = 4,
+    NotFound = 5,
+    AlreadyExists = 6,
+    PermissionDenied = 7,
+    ResourceExhausted = 8,
+    FailedPrecondition = 9,
+    Aborted = 10,
+    OutOfRange = 11,
+    Unimplemented = 12,
+    Internal = 13,
+    Unavailable = 14,
+    DataLoss = 15,
+    Unauthenticated = 16,
+}
+impl Code {
+    /// String value of the enum field names used in the ProtoBuf definition.
+    ///
+    /// The values are not transformed in any way and thus are considered stable
+    /// (if the ProtoBuf definition does not change) and safe for programmatic use.
+    pub fn as_str_name(&self) -> &'static str {
+        match self {
+            Code::Ok => "CODE_OK",
+            Code::Cancelled => "CODE_CANCELLED",
+            Code::Unknown => "CODE_UNKNOWN",
+            Code::InvalidArgument => "CODE_INVALID_ARGUMENT",
+            Code::DeadlineExceeded => "CODE_DEADLINE_EXCEEDED",
+            Code::NotFound => "CODE_NOT_FOUND",
+            Code::AlreadyExists => "CODE_ALREADY_EXISTS",
+            Code::PermissionDenied => "CODE_PERMISSION_DENIED",
+            Code::ResourceExhausted => "CODE_RESOURCE_EXHAUSTED",
+            Code::FailedPrecondition => "CODE_FAILED_PRECONDITION",
+            Code::Aborted => "CODE_ABORTED",
+            Code::OutOfRange => "CODE_OUT_OF_RANGE",
+            Code::Unimplemented => "CODE_UNIMPLEMENTED",
+            Code::Internal => "CODE_INTERNAL",
+            Code::Unavailable => "CODE_UNAVAILABLE",
+            Code::DataLoss => "CODE_DATA_LOSS",
+            Code::Unauthenticated => "CODE_UNAUTHENTICATED",
+        }
+    }
+    /// Creates an enum from field names used in the ProtoBuf definition.
+    pub fn from_str_name(value: &str) -> ::core::option::Option<Self> {
+        match value {
+            "CODE_OK" => Some(Self::Ok),
+            "CODE_CANCELLED" => Some(Self::Cancelled),
+            "CODE_UNKNOWN" => Some(Self::Unknown),
+            "CODE_INVALID_ARGUMENT" => Some(Self::InvalidArgument),
+            "CODE_DEADLINE_EXCEEDED" => Some(Self::DeadlineExceeded),
+            "CODE_NOT_FOUND" => Some(Self::NotFound),
+            "CODE_ALREADY_EXISTS" => Some(Self::AlreadyExists),
+            "CODE_PERMISSION_DENIED" => Some(Self::PermissionDenied),
+            "CODE_RESOURCE_EXHAUSTED" => Some(Self::ResourceExhausted),
+            "CODE_FAILED_PRECONDITION" => Some(Self::FailedPrecondition),
+            "CODE_ABORTED" => Some(Self::Aborted),
+            "CODE_OUT_OF_RANGE" => Some(Self::OutOfRange),
+            "CODE_UNIMPLEMENTED" => Some(Self::Unimplemented),
+            "CODE_INTERNAL" => Some(Self::Internal),
+            "CODE_UNAVAILABLE" => Some(Self::Unavailable),
+            "CODE_DATA_LOSS" => Some(Self::DataLoss),
+            "CODE_UNAUTHENTICATED" => Some(Self::Unauthenticated),
+            _ => None,
+        }
+    }
+}
+/// Encoded file descriptor set for the `errorspec` package
+pub const FILE_DESCRIPTOR_SET: &[u8] = &[
+    0x0a, 0xe9, 0x11, 0x0a, 0x19, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x70, 0x65, 0x63, 0x2f, 0x65,
+    0x72, 0x72, 0x6f, 0x72, 0x73, 0x70, 0x65, 0x63, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x09,
+    0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x70, 0x65, 0x63, 0x1a, 0x20, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+    0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x64, 0x65, 0x73, 0x63, 0x72,
+    0x69, 0x70, 0x74, 0x6f, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xb6, 0x01, 0x0a, 0x09,
+    0x45, 0x72, 0x72, 0x6f, 0x72, 0x53, 0x70, 0x65, 0x63, 0x12, 0x23, 0x0a, 0x04, 0x63, 0x6f, 0x64,
+    0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0f, 0x2e, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73,
+    0x70, 0x65, 0x63, 0x2e, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x18,
+    0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+    0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x69, 0x65, 0x6c,
+    0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x1a,
+    0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09,
+    0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x67,
+    0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x61,
+    0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x78, 0x74, 0x65,
+    0x72, 0x6e, 0x61, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x65, 0x78, 0x74, 0x65,
+    0x72, 0x6e, 0x61, 0x6c, 0x2a, 0x8c, 0x03, 0x0a, 0x04, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x0b, 0x0a,
+    0x07, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x4f, 0x4b, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x43, 0x4f,
+    0x44, 0x45, 0x5f, 0x43, 0x41, 0x4e, 0x43, 0x45, 0x4c, 0x4c, 0x45, 0x44, 0x10, 0x01, 0x12, 0x10,
+    0x0a, 0x0c, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x02,
+    0x12, 0x19, 0x0a, 0x15, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44,
+    0x5f, 0x41, 0x52, 0x47, 0x55, 0x4d, 0x45, 0x4e, 0x54, 0x10, 0x03, 0x12, 0x1a, 0x0a, 0x16, 0x43,
+    0x4f, 0x44, 0x45, 0x5f, 0x44, 0x45, 0x41, 0x44, 0x4c, 0x49, 0x4e, 0x45, 0x5f, 0x45, 0x58, 0x43,
+    0x45, 0x45, 0x44, 0x45, 0x44, 0x10, 0x04, 0x12, 0x12, 0x0a, 0x0e, 0x43, 0x4f, 0x44, 0x45, 0x5f,
+    0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x05, 0x12, 0x17, 0x0a, 0x13, 0x43,
+    0x4f, 0x44, 0x45, 0x5f, 0x41, 0x4c, 0x52, 0x45, 0x41, 0x44, 0x59, 0x5f, 0x45, 0x58, 0x49, 0x53,
+    0x54, 0x53, 0x10, 0x06, 0x12, 0x1a, 0x0a, 0x16, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x50, 0x45, 0x52,
+    0x4d, 0x49, 0x53, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x44, 0x45, 0x4e, 0x49, 0x45, 0x44, 0x10, 0x07,
+    0x12, 0x1b, 0x0a, 0x17, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x52, 0x45, 0x53, 0x4f, 0x55, 0x52, 0x43,
+    0x45, 0x5f, 0x45, 0x58, 0x48, 0x41, 0x55, 0x53, 0x54, 0x45, 0x44, 0x10, 0x08, 0x12, 0x1c, 0x0a,
+    0x18, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x5f, 0x50, 0x52, 0x45,
+    0x43, 0x4f, 0x4e, 0x44, 0x49, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x09, 0x12, 0x10, 0x0a, 0x0c, 0x43,
+    0x4f, 0x44, 0x45, 0x5f, 0x41, 0x42, 0x4f, 0x52, 0x54, 0x45, 0x44, 0x10, 0x0a, 0x12, 0x15, 0x0a,
+    0x11, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x4f, 0x55, 0x54, 0x5f, 0x4f, 0x46, 0x5f, 0x52, 0x41, 0x4e,
+    0x47, 0x45, 0x10, 0x0b, 0x12, 0x16, 0x0a, 0x12, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x55, 0x4e, 0x49,
+    0x4d, 0x50, 0x4c, 0x45, 0x4d, 0x45, 0x4e, 0x54, 0x45, 0x44, 0x10, 0x0c, 0x12, 0x11, 0x0a, 0x0d,
+    0x43, 0x4f, 0x44, 0x45, 0x5f, 0x49, 0x4e, 0x54, 0x45, 0x52, 0x4e, 0x41, 0x4c, 0x10, 0x0d, 0x12,
+    0x14, 0x0a, 0x10, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x55, 0x4e, 0x41, 0x56, 0x41, 0x49, 0x4c, 0x41,
+    0x42, 0x4c, 0x45, 0x10, 0x0e, 0x12, 0x12, 0x0a, 0x0e, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x44, 0x41,
+    0x54, 0x41, 0x5f, 0x4c, 0x4f, 0x53, 0x53, 0x10, 0x0f, 0x12, 0x18, 0x0a, 0x14, 0x43, 0x4f, 0x44,
+    0x45, 0x5f, 0x55, 0x4e, 0x41, 0x55, 0x54, 0x48, 0x45, 0x4e, 0x54, 0x49, 0x43, 0x41, 0x54, 0x45,
+    0x44, 0x10, 0x10, 0x3a, 0x36, 0x0a, 0x06, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x12, 0x1c, 0x2e,
+    0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+    0x45, 0x6e, 0x75, 0x6d, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0xb4, 0x87, 0x03, 0x20,
+    0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x3a, 0x4f, 0x0a, 0x05, 0x65,
+    0x72, 0x72, 0x6f, 0x72, 0x12, 0x21, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+    0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6e, 0x75, 0x6d, 0x56, 0x61, 0x6c, 0x75, 0x65,
+    0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0xb4, 0x87, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+    0x14, 0x2e, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x70, 0x65, 0x63, 0x2e, 0x45, 0x72, 0x72, 0x6f,
+    0x72, 0x53, 0x70, 0x65, 0x63, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x42, 0xb9, 0x01, 0x0a,
+    0x0d, 0x63, 0x6f, 0x6d, 0x2e, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x70, 0x65, 0x63, 0x42, 0x0e,
+    0x45, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x70, 0x65, 0x63, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01,
+    0x5a, 0x54, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x6d, 0x69,
+    0x72, 0x73, 0x61, 0x6c, 0x61, 0x72, 0x73, 0x61, 0x66, 0x61, 0x65, 0x69, 0x2f, 0x70, 0x72, 0x6f,
+    0x74, 0x6f, 0x2d, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x2d, 0x68, 0x61, 0x6e, 0x64, 0x6c, 0x69, 0x6e,
+    0x67, 0x2f, 0x61, 0x75, 0x74, 0x6f, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x64, 0x2f,
+    0x67, 0x6f, 0x2f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x70, 0x65, 0x63, 0x3b, 0x65, 0x72, 0x72,
+    0x6f, 0x72, 0x73, 0x70, 0x65, 0x63, 0xa2, 0x02, 0x03, 0x45, 0x58, 0x58, 0xaa, 0x02, 0x09, 0x45,
+    0x72, 0x72, 0x6f, 0x72, 0x73, 0x70, 0x65, 0x63, 0xca, 0x02, 0x09, 0x45, 0x72, 0x72, 0x6f, 0x72,
+    0x73, 0x70, 0x65, 0x63, 0xe2, 0x02, 0x15, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x70, 0x65, 0x63,
+    0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x09, 0x45,
+    0x72, 0x72, 0x6f, 0x72, 0x73, 0x70, 0x65, 0x63, 0x4a, 0x89, 0x0a, 0x0a, 0x06, 0x12, 0x04, 0x00,
+    0x00, 0x2b, 0x01, 0x0a, 0x08, 0x0a, 0x01, 0x0c, 0x12, 0x03, 0x00, 0x00, 0x12, 0x0a, 0x08, 0x0a,
+    0x01, 0x02, 0x12, 0x03, 0x02, 0x00, 0x12, 0x0a, 0x09, 0x0a, 0x02, 0x03, 0x00, 0x12, 0x03, 0x04,
+    0x00, 0x2a, 0x0a, 0x08, 0x0a, 0x01, 0x08, 0x12, 0x03, 0x06, 0x00, 0x6b, 0x0a, 0x09, 0x0a, 0x02,
+    0x08, 0x0b, 0x12, 0x03, 0x06, 0x00, 0x6b, 0x0a, 0x0a, 0x0a, 0x02, 0x05, 0x00, 0x12, 0x04, 0x08,
+    0x00, 0x1a, 0x01, 0x0a, 0x0a, 0x0a, 0x03, 0x05, 0x00, 0x01, 0x12, 0x03, 0x08, 0x05, 0x09, 0x0a,
+    0x0b, 0x0a, 0x04, 0x05, 0x00, 0x02, 0x00, 0x12, 0x03, 0x09, 0x02, 0x0e, 0x0a, 0x0c, 0x0a, 0x05,
+    0x05, 0x00, 0x02, 0x00, 0x01, 0x12, 0x03, 0x09, 0x02, 0x09, 0x0a, 0x0c, 0x0a, 0x05, 0x05, 0x00,
+    0x02, 0x00, 0x02, 0x12, 0x03, 0x09, 0x0c, 0x0d, 0x0a, 0x0b, 0x0a, 0x04, 0x05, 0x00, 0x02, 0x01,
+    0x12, 0x03, 0x0a, 0x02, 0x15, 0x0a, 0x0c, 0x0a, 0x05, 0x05, 0x00, 0x02, 0x01, 0x01, 0x12, 0x03,
+    0x0a, 0x02, 0x10, 0x0a, 0x0c, 0x0a, 0x05, 0x05, 0x00, 0x02, 0x01, 0x02, 0x12, 0x03, 0x0a, 0x13,
+    0x14, 0x0a, 0x0b, 0x0a, 0x04, 0x05, 0x00, 0x02, 0x02, 0x12, 0x03, 0x0b, 0x02, 0x13, 0x0a, 0x0c,
+    0x0a, 0x05, 0x05, 0x00, 0x02, 0x02, 0x01, 0x12, 0x03, 0x0b, 0x02, 0x0e, 0x0a, 0x0c, 0x0a, 0x05,
+    0x05, 0x00, 0x02, 0x02, 0x02, 0x12, 0x03, 0x0b, 0x11, 0x12, 0x0a, 0x0b, 0x0a, 0x04, 0x05, 0x00,
+    0x02, 0x03, 0x12, 0x03, 0x0c, 0x02, 0x1c, 0x0a, 0x0c, 0x0a, 0x05, 0x05, 0x00, 0x02, 0x03, 0x01,
+    0x12, 0x03, 0x0c, 0x02, 0x17, 0x0a, 0x0c, 0x0a, 0x05, 0x05, 0x00, 0x02, 0x03, 0x02, 0x12, 0x03,
+    0x0c, 0x1a, 0x1b, 0x0a, 0x0b, 0x0a, 0x04, 0x05, 0x00, 0x02, 0x04, 0x12, 0x03, 0x0d, 0x02, 0x1d,
+    0x0a, 0x0c, 0x0a, 0x05, 0x05, 0x00, 0x02, 0x04, 0x01, 0x12, 0x03, 0x0d, 0x02, 0x18, 0x0a, 0x0c,
+    0x0a, 0x05, 0x05, 0x00, 0x02, 0x04, 0x02, 0x12, 0x03, 0x0d, 0x1b, 0x1c, 0x0a, 0x0b, 0x0a, 0x04,
+    0x05, 0x00, 0x02, 0x05, 0x12, 0x03, 0x0e, 0x02, 0x15, 0x0a, 0x0c, 0x0a, 0x05, 0x05, 0x00, 0x02,
+    0x05, 0x01, 0x12, 0x03, 0x0e, 0x02, 0x10, 0x0a, 0x0c, 0x0a, 0x05, 0x05, 0x00, 0x02, 0x05, 0x02,
+    0x12, 0x03, 0x0e, 0x13, 0x14, 0x0a, 0x0b, 0x0a, 0x04, 0x05, 0x00, 0x02, 0x06, 0x12, 0x03, 0x0f,
+    0x02, 0x1a, 0x0a, 0x0c, 0x0a, 0x05, 0x05, 0x00, 0x02, 0x06, 0x01, 0x12, 0x03, 0x0f, 0x02, 0x15,
+    0x0a, 0x0c, 0x0a, 0x05, 0x05, 0x00, 0x02, 0x06, 0x02, 0x12, 0x03, 0x0f, 0x18, 0x19, 0x0a, 0x0b,
+    0x0a, 0x04, 0x05, 0x00, 0x02, 0x07, 0x12, 0x03, 0x10, 0x02, 0x1d, 0x0a, 0x0c, 0x0a, 0x05, 0x05,
+    0x00, 0x02, 0x07, 0x01, 0x12, 0x03, 0x10, 0x02, 0x18, 0x0a, 0x0c, 0x0a, 0x05, 0x05, 0x00, 0x02,
+    0x07, 0x02, 0x12, 0x03, 0x10, 0x1b, 0x1c, 0x0a, 0x0b, 0x0a, 0x04, 0x05, 0x00, 0x02, 0x08, 0x12,
+    0x03, 0x11, 0x02, 0x1e, 0x0a, 0x0c, 0x0a, 0x05, 0x05, 0x00, 0x02, 0x08, 0x01, 0x12, 0x03, 0x11,
+    0x02, 0x19, 0x0a, 0x0c, 0x0a, 0x05, 0x05, 0x00, 0x02, 0x08, 0x02, 0x12, 0x03, 0x11, 0x1c, 0x1d,
+    0x0a, 0x0b, 0x0a, 0x04, 0x05, 0x00, 0x02, 0x09, 0x12, 0x03, 0x12, 0x02, 0x1f, 0x0a, 0x0c, 0x0a,
+    0x05, 0x05, 0x00, 0x02, 0x09, 0x01, 0x12, 0x03, 0x12, 0x02, 0x1a, 0x0a, 0x0c, 0x0a, 0x05, 0x05,
+    0x00, 0x02, 0x09, 0x02, 0x12, 0x03, 0x12, 0x1d, 0x1e, 0x0a, 0x0b, 0x0a, 0x04, 0x05, 0x00, 0x02,
+    0x0a, 0x12, 0x03, 0x13, 0x02, 0x14, 0x0a, 0x0c, 0x0a, 0x05, 0x05, 0x00, 0x02, 0x0a, 0x01, 0x12,
+    0x03, 0x13, 0x02, 0x0e, 0x0a, 0x0c, 0x0a, 0x05, 0x05, 0x00, 0x02, 0x0a, 0x02, 0x12, 0x03, 0x13,
+    0x11, 0x13, 0x0a, 0x0b, 0x0a, 0x04, 0x05, 0x00, 0x02, 0x0b, 0x12, 0x03, 0x14, 0x02, 0x19, 0x0a,
+    0x0c, 0x0a, 0x05, 0x05, 0x00, 0x02, 0x0b, 0x01, 0x12, 0x03, 0x14, 0x02, 0x13, 0x0a, 0x0c, 0x0a,
+    0x05, 0x05, 0x00, 0x02, 0x0b, 0x02, 0x12, 0x03, 0x14, 0x16, 0x18, 0x0a, 0x0b, 0x0a, 0x04, 0x05,
+    0x00, 0x02, 0x0c, 0x12, 0x03, 0x15, 0x02, 0x1a, 0x0a, 0x0c, 0x0a, 0x05, 0x05, 0x00, 0x02, 0x0c,
+    0x01, 0x12, 0x03, 0x15, 0x02, 0x14, 0x0a, 0x0c, 0x0a, 0x05, 0x05, 0x00, 0x02, 0x0c, 0x02, 0x12,
+    0x03, 0x15, 0x17, 0x19, 0x0a, 0x0b, 0x0a, 0x04, 0x05, 0x00, 0x02, 0x0d, 0x12, 0x03, 0x16, 0x02,
+    0x15, 0x0a, 0x0c, 0x0a, 0x05, 0x05, 0x00, 0x02, 0x0d, 0x01, 0x12, 0x03, 0x16, 0x02, 0x0f, 0x0a,
+    0x0c, 0x0a, 0x05, 0x05, 0x00, 0x02, 0x0d, 0x02, 0x12, 0x03, 0x16, 0x12, 0x14, 0x0a, 0x0b, 0x0a,
+    0x04, 0x05, 0x00, 0x02, 0x0e, 0x12, 0x03, 0x17, 0x02, 0x18, 0x0a, 0x0c, 0x0a, 0x05, 0x05, 0x00,
+    0x02, 0x0e, 0x01, 0x12, 0x03, 0x17, 0x02, 0x12, 0x0a, 0x0c, 0x0a, 0x05, 0x05, 0x00, 0x02, 0x0e,
+    0x02, 0x12, 0x03, 0x17, 0x15, 0x17, 0x0a, 0x0b, 0x0a, 0x04, 0x05, 0x00, 0x02, 0x0f, 0x12, 0x03,
+    0x18, 0x02, 0x16, 0x0a, 0x0c, 0x0a, 0x05, 0x05, 0x00, 0x02, 0x0f, 0x01, 0x12, 0x03, 0x18, 0x02,
+    0x10, 0x0a, 0x0c, 0x0a, 0x05, 0x05, 0x00, 0x02, 0x0f, 0x02, 0x12, 0x03, 0x18, 0x13, 0x15, 0x0a,
+    0x0b, 0x0a, 0x04, 0x05, 0x00, 0x02, 0x10, 0x12, 0x03, 0x19, 0x02, 0x1c, 0x0a, 0x0c, 0x0a, 0x05,
+    0x05, 0x00, 0x02, 0x10, 0x01, 0x12, 0x03, 0x19, 0x02, 0x16, 0x0a, 0x0c, 0x0a, 0x05, 0x05, 0x00,
+    0x02, 0x10, 0x02, 0x12, 0x03, 0x19, 0x19, 0x1b, 0x0a, 0x0a, 0x0a, 0x02, 0x04, 0x00, 0x12, 0x04,
+    0x1c, 0x00, 0x23, 0x01, 0x0a, 0x0a, 0x0a, 0x03, 0x04, 0x00, 0x01, 0x12, 0x03, 0x1c, 0x08, 0x11,
+    0x0a, 0x0b, 0x0a, 0x04, 0x04, 0x00, 0x02, 0x00, 0x12, 0x03, 0x1d, 0x02, 0x10, 0x0a, 0x0c, 0x0a,
+    0x05, 0x04, 0x00, 0x02, 0x00, 0x06, 0x12, 0x03, 0x1d, 0x02, 0x06, 0x0a, 0x0c, 0x0a, 0x05, 0x04,
+    0x00, 0x02, 0x00, 0x01, 0x12, 0x03, 0x1d, 0x07, 0x0b, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x00, 0x02,
+    0x00, 0x03, 0x12, 0x03, 0x1d, 0x0e, 0x0f, 0x0a, 0x0b, 0x0a, 0x04, 0x04, 0x00, 0x02, 0x01, 0x12,
+    0x03, 0x1e, 0x02, 0x15, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x00, 0x02, 0x01, 0x05, 0x12, 0x03, 0x1e,
+    0x02, 0x08, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x00, 0x02, 0x01, 0x01, 0x12, 0x03, 0x1e, 0x09, 0x10,
+    0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x00, 0x02, 0x01, 0x03, 0x12, 0x03, 0x1e, 0x13, 0x14, 0x0a, 0x0b,
+    0x0a, 0x04, 0x04, 0x00, 0x02, 0x02, 0x12, 0x03, 0x1f, 0x02, 0x13, 0x0a, 0x0c, 0x0a, 0x05, 0x04,
+    0x00, 0x02, 0x02, 0x05, 0x12, 0x03, 0x1f, 0x02, 0x08, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x00, 0x02,
+    0x02, 0x01, 0x12, 0x03, 0x1f, 0x09, 0x0e, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x00, 0x02, 0x02, 0x03,
+    0x12, 0x03, 0x1f, 0x11, 0x12, 0x0a, 0x0b, 0x0a, 0x04, 0x04, 0x00, 0x02, 0x03, 0x12, 0x03, 0x20,
+    0x02, 0x1f, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x00, 0x02, 0x03, 0x04, 0x12, 0x03, 0x20, 0x02, 0x0a,
+    0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x00, 0x02, 0x03, 0x05, 0x12, 0x03, 0x20, 0x0b, 0x11, 0x0a, 0x0c,
+    0x0a, 0x05, 0x04, 0x00, 0x02, 0x03, 0x01, 0x12, 0x03, 0x20, 0x12, 0x1a, 0x0a, 0x0c, 0x0a, 0x05,
+    0x04, 0x00, 0x02, 0x03, 0x03, 0x12, 0x03, 0x20, 0x1d, 0x1e, 0x0a, 0x0b, 0x0a, 0x04, 0x04, 0x00,
+    0x02, 0x04, 0x12, 0x03, 0x21, 0x02, 0x15, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x00, 0x02, 0x04, 0x05,
+    0x12, 0x03, 0x21, 0x02, 0x06, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x00, 0x02, 0x04, 0x01, 0x12, 0x03,
+    0x21, 0x07, 0x10, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x00, 0x02, 0x04, 0x03, 0x12, 0x03, 0x21, 0x13,
+    0x14, 0x0a, 0x0b, 0x0a, 0x04, 0x04, 0x00, 0x02, 0x05, 0x12, 0x03, 0x22, 0x02, 0x14, 0x0a, 0x0c,
+    0x0a, 0x05, 0x04, 0x00, 0x02, 0x05, 0x05, 0x12, 0x03, 0x22, 0x02, 0x06, 0x0a, 0x0c, 0x0a, 0x05,
+    0x04, 0x00, 0x02, 0x05, 0x01, 0x12, 0x03, 0x22, 0x07, 0x0f, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x00,
+    0x02, 0x05, 0x03, 0x12, 0x03, 0x22, 0x12, 0x13, 0x0a, 0x09, 0x0a, 0x01, 0x07, 0x12, 0x04, 0x25,
+    0x00, 0x27, 0x01, 0x0a, 0x09, 0x0a, 0x02, 0x07, 0x00, 0x12, 0x03, 0x26, 0x02, 0x18, 0x0a, 0x0a,
+    0x0a, 0x03, 0x07, 0x00, 0x02, 0x12, 0x03, 0x25, 0x07, 0x22, 0x0a, 0x0a, 0x0a, 0x03, 0x07, 0x00,
+    0x05, 0x12, 0x03, 0x26, 0x02, 0x08, 0x0a, 0x0a, 0x0a, 0x03, 0x07, 0x00, 0x01, 0x12, 0x03, 0x26,
+    0x09, 0x0f, 0x0a, 0x0a, 0x0a, 0x03, 0x07, 0x00, 0x03, 0x12, 0x03, 0x26, 0x12, 0x17, 0x0a, 0x09,
+    0x0a, 0x01, 0x07, 0x12, 0x04, 0x29, 0x00, 0x2b, 0x01, 0x0a, 0x09, 0x0a, 0x02, 0x07, 0x01, 0x12,
+    0x03, 0x2a, 0x02, 0x1a, 0x0a, 0x0a, 0x0a, 0x03, 0x07, 0x01, 0x02, 0x12, 0x03, 0x29, 0x07, 0x27,
+    0x0a, 0x0a, 0x0a, 0x03, 0x07, 0x01, 0x06, 0x12, 0x03, 0x2a, 0x02, 0x0b, 0x0a, 0x0a, 0x0a, 0x03,
+    0x07, 0x01, 0x01, 0x12, 0x03, 0x2a, 0x0c, 0x11, 0x0a, 0x0a, 0x0a, 0x03, 0x07, 0x01, 0x03, 0x12,
+    0x03, 0x2a, 0x14, 0x19, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+];
+// @@protoc_insertion_point(module)
@@ -0,0 +1,129 @@
+// @generated
+// This file is @generated by prost-build.
+#[allow(clippy::derive_partial_eq_without_eq)]
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct FieldConstraints {
+    #[prost(bool, tag="1")]
+    pub required: bool,
+    #[prost(message, optional, tag="2")]
+    pub string: ::core::option::Option<StringConstraints>,
+}
+#[allow(clippy::derive_partial_eq_without_eq)]
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct StringConstraints {
+    #[prost(uint64, optional, tag="1")]
+    pub min_len: ::core::option::Option<u64>,
+    #[prost(uint64, optional, tag="2")]
+    pub max_len: ::core::option::Option<u64>,
+    #[prost(string, optional, tag="3")]
+    pub pattern: ::core::option::Option<::prost::alloc::string::String>,
+    #[prost(bool, tag="4")]
+    pub email: bool,
+}
+/// Encoded file descriptor set for the `fieldspec` package
+pub const FILE_DESCRIPTOR_SET: &[u8] = &[
+    0x0a, 0xde, 0x0c, 0x0a, 0x19, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x70, 0x65, 0x63, 0x2f, 0x66,
+    0x69, 0x65, 0x6c, 0x64, 0x73, 0x70, 0x65, 0x63, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x09,
+    0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x70, 0x65, 0x63, 0x1a, 0x20, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+    0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x64, 0x65, 0x73, 0x63, 0x72,
+    0x69, 0x70, 0x74, 0x6f, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x64, 0x0a, 0x10, 0x46,
+    0x69, 0x65, 0x6c, 0x64, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x74, 0x73, 0x12,
+    0x1a, 0x0a, 0x08, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+    0x08, 0x52, 0x08, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x12, 0x34, 0x0a, 0x06, 0x73,
+    0x74, 0x72, 0x69, 0x6e, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x66, 0x69,
+    0x65, 0x6c, 0x64, 0x73, 0x70, 0x65, 0x63, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x43, 0x6f,
+    0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x74, 0x73, 0x52, 0x06, 0x73, 0x74, 0x72, 0x69, 0x6e,
+    0x67, 0x22, 0xa8, 0x01, 0x0a, 0x11, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x6e, 0x73,
+    0x74, 0x72, 0x61, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x1c, 0x0a, 0x07, 0x6d, 0x69, 0x6e, 0x5f, 0x6c,
+    0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x48, 0x00, 0x52, 0x06, 0x6d, 0x69, 0x6e, 0x4c,
+    0x65, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x1c, 0x0a, 0x07, 0x6d, 0x61, 0x78, 0x5f, 0x6c, 0x65, 0x6e,
+    0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x48, 0x01, 0x52, 0x06, 0x6d, 0x61, 0x78, 0x4c, 0x65, 0x6e,
+    0x88, 0x01, 0x01, 0x12, 0x1d, 0x0a, 0x07, 0x70, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x18, 0x03,
+    0x20, 0x01, 0x28, 0x09, 0x48, 0x02, 0x52, 0x07, 0x70, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x88,
+    0x01, 0x01, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28,
+    0x08, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x6d, 0x69, 0x6e,
+    0x5f, 0x6c, 0x65, 0x6e, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x6c, 0x65, 0x6e,
+    0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x70, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x3a, 0x52, 0x0a, 0x05,
+    0x66, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x1d, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+    0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x4f, 0x70, 0x74,
+    0x69, 0x6f, 0x6e, 0x73, 0x18, 0x98, 0x88, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x66,
+    0x69, 0x65, 0x6c, 0x64, 0x73, 0x70, 0x65, 0x63, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x43, 0x6f,
+    0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x74, 0x73, 0x52, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64,
+    0x42, 0xb9, 0x01, 0x0a, 0x0d, 0x63, 0x6f, 0x6d, 0x2e, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x70,
+    0x65, 0x63, 0x42, 0x0e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x70, 0x65, 0x63, 0x50, 0x72, 0x6f,
+    0x74, 0x6f, 0x50, 0x01, 0x5a, 0x54, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
+    0x2f, 0x61, 0x6d, 0x69, 0x72, 0x73, 0x61, 0x6c, 0x61, 0x72, 0x73, 0x61, 0x66, 0x61, 0x65, 0x69,
+    0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2d, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x2d, 0x68, 0x61, 0x6e,
+    0x64, 0x6c, 0x69, 0x6e, 0x67, 0x2f, 0x61, 0x75, 0x74, 0x6f, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61,
+    0x74, 0x65, 0x64, 0x2f, 0x67, 0x6f, 0x2f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x70, 0x65, 0x63,
+    0x3b, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x70, 0x65, 0x63, 0xa2, 0x02, 0x03, 0x46, 0x58, 0x58,
+    0xaa, 0x02, 0x09, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x70, 0x65, 0x63, 0xca, 0x02, 0x09, 0x46,
+    0x69, 0x65, 0x6c, 0x64, 0x73, 0x70, 0x65, 0x63, 0xe2, 0x02, 0x15, 0x46, 0x69, 0x65, 0x6c, 0x64,
+    0x73, 0x70, 0x65, 0x63, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
+    0xea, 0x02, 0x09, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x70, 0x65, 0x63, 0x4a, 0xea, 0x07, 0x0a,
+    0x06, 0x12, 0x04, 0x00, 0x00, 0x1e, 0x01, 0x0a, 0x08, 0x0a, 0x01, 0x0c, 0x12, 0x03, 0x00, 0x00,
+    0x12, 0x0a, 0x08, 0x0a, 0x01, 0x02, 0x12, 0x03, 0x02, 0x00, 0x12, 0x0a, 0x09, 0x0a, 0x02, 0x03,
+    0x00, 0x12, 0x03, 0x04, 0x00, 0x2a, 0x0a, 0x08, 0x0a, 0x01, 0x08, 0x12, 0x03, 0x06, 0x00, 0x6b,
+    0x0a, 0x09, 0x0a, 0x02, 0x08, 0x0b, 0x12, 0x03, 0x06, 0x00, 0x6b, 0x0a, 0x96, 0x01, 0x0a, 0x02,
+    0x04, 0x00, 0x12, 0x04, 0x0a, 0x00, 0x0e, 0x01, 0x1a, 0x89, 0x01, 0x20, 0x46, 0x69, 0x65, 0x6c,
+    0x64, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x74, 0x73, 0x20, 0x61, 0x72, 0x65,
+    0x20, 0x74, 0x68, 0x65, 0x20, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x20, 0x61, 0x20, 0x66, 0x69,
+    0x65, 0x6c, 0x64, 0x20, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x73, 0x2e, 0x20, 0x45, 0x76, 0x65,
+    0x72, 0x79, 0x20, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x74, 0x20, 0x68, 0x61,
+    0x73, 0x20, 0x61, 0x6e, 0x20, 0x49, 0x44, 0x2c, 0x0a, 0x20, 0x65, 0x2e, 0x67, 0x2e, 0x20, 0x22,
+    0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x6d, 0x61, 0x78, 0x5f, 0x6c, 0x65, 0x6e, 0x22, 0x2c,
+    0x20, 0x74, 0x68, 0x61, 0x74, 0x20, 0x76, 0x69, 0x6f, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+    0x20, 0x61, 0x72, 0x65, 0x20, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x20, 0x77, 0x69,
+    0x74, 0x68, 0x2e, 0x0a, 0x0a, 0x0a, 0x0a, 0x03, 0x04, 0x00, 0x01, 0x12, 0x03, 0x0a, 0x08, 0x18,
+    0x0a, 0x56, 0x0a, 0x04, 0x04, 0x00, 0x02, 0x00, 0x12, 0x03, 0x0c, 0x02, 0x14, 0x1a, 0x49, 0x20,
+    0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x20, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x73,
+    0x20, 0x74, 0x68, 0x65, 0x20, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x20, 0x76, 0x61, 0x6c,
+    0x75, 0x65, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x2c,
+    0x20, 0x65, 0x2e, 0x67, 0x2e, 0x20, 0x74, 0x68, 0x65, 0x20, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x20,
+    0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x00, 0x02, 0x00,
+    0x05, 0x12, 0x03, 0x0c, 0x02, 0x06, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x00, 0x02, 0x00, 0x01, 0x12,
+    0x03, 0x0c, 0x07, 0x0f, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x00, 0x02, 0x00, 0x03, 0x12, 0x03, 0x0c,
+    0x12, 0x13, 0x0a, 0x0b, 0x0a, 0x04, 0x04, 0x00, 0x02, 0x01, 0x12, 0x03, 0x0d, 0x02, 0x1f, 0x0a,
+    0x0c, 0x0a, 0x05, 0x04, 0x00, 0x02, 0x01, 0x06, 0x12, 0x03, 0x0d, 0x02, 0x13, 0x0a, 0x0c, 0x0a,
+    0x05, 0x04, 0x00, 0x02, 0x01, 0x01, 0x12, 0x03, 0x0d, 0x14, 0x1a, 0x0a, 0x0c, 0x0a, 0x05, 0x04,
+    0x00, 0x02, 0x01, 0x03, 0x12, 0x03, 0x0d, 0x1d, 0x1e, 0x0a, 0x5b, 0x0a, 0x02, 0x04, 0x01, 0x12,
+    0x04, 0x12, 0x00, 0x1a, 0x01, 0x1a, 0x4f, 0x20, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x43, 0x6f,
+    0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x74, 0x73, 0x20, 0x64, 0x6f, 0x6e, 0x27, 0x74, 0x20,
+    0x61, 0x70, 0x70, 0x6c, 0x79, 0x20, 0x74, 0x6f, 0x20, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x20, 0x73,
+    0x74, 0x72, 0x69, 0x6e, 0x67, 0x73, 0x2c, 0x20, 0x77, 0x68, 0x69, 0x63, 0x68, 0x20, 0x6f, 0x6e,
+    0x6c, 0x79, 0x20, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x0a, 0x20, 0x72, 0x65, 0x6a,
+    0x65, 0x63, 0x74, 0x73, 0x2e, 0x0a, 0x0a, 0x0a, 0x0a, 0x03, 0x04, 0x01, 0x01, 0x12, 0x03, 0x12,
+    0x08, 0x19, 0x0a, 0x3f, 0x0a, 0x04, 0x04, 0x01, 0x02, 0x00, 0x12, 0x03, 0x14, 0x02, 0x1e, 0x1a,
+    0x32, 0x20, 0x6d, 0x69, 0x6e, 0x5f, 0x6c, 0x65, 0x6e, 0x20, 0x61, 0x6e, 0x64, 0x20, 0x6d, 0x61,
+    0x78, 0x5f, 0x6c, 0x65, 0x6e, 0x20, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x20, 0x63, 0x68, 0x61, 0x72,
+    0x61, 0x63, 0x74, 0x65, 0x72, 0x73, 0x2c, 0x20, 0x6e, 0x6f, 0x74, 0x20, 0x62, 0x79, 0x74, 0x65,
+    0x73, 0x2e, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x01, 0x02, 0x00, 0x04, 0x12, 0x03, 0x14, 0x02,
+    0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x01, 0x02, 0x00, 0x05, 0x12, 0x03, 0x14, 0x0b, 0x11, 0x0a,
+    0x0c, 0x0a, 0x05, 0x04, 0x01, 0x02, 0x00, 0x01, 0x12, 0x03, 0x14, 0x12, 0x19, 0x0a, 0x0c, 0x0a,
+    0x05, 0x04, 0x01, 0x02, 0x00, 0x03, 0x12, 0x03, 0x14, 0x1c, 0x1d, 0x0a, 0x0b, 0x0a, 0x04, 0x04,
+    0x01, 0x02, 0x01, 0x12, 0x03, 0x15, 0x02, 0x1e, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x01, 0x02, 0x01,
+    0x04, 0x12, 0x03, 0x15, 0x02, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x01, 0x02, 0x01, 0x05, 0x12,
+    0x03, 0x15, 0x0b, 0x11, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x01, 0x02, 0x01, 0x01, 0x12, 0x03, 0x15,
+    0x12, 0x19, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x01, 0x02, 0x01, 0x03, 0x12, 0x03, 0x15, 0x1c, 0x1d,
+    0x0a, 0x4e, 0x0a, 0x04, 0x04, 0x01, 0x02, 0x02, 0x12, 0x03, 0x17, 0x02, 0x1e, 0x1a, 0x41, 0x20,
+    0x70, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x20, 0x69, 0x73, 0x20, 0x61, 0x6e, 0x20, 0x52, 0x45,
+    0x32, 0x20, 0x72, 0x65, 0x67, 0x75, 0x6c, 0x61, 0x72, 0x20, 0x65, 0x78, 0x70, 0x72, 0x65, 0x73,
+    0x73, 0x69, 0x6f, 0x6e, 0x20, 0x74, 0x68, 0x61, 0x74, 0x20, 0x6d, 0x75, 0x73, 0x74, 0x20, 0x6d,
+    0x61, 0x74, 0x63, 0x68, 0x20, 0x74, 0x68, 0x65, 0x20, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x2e, 0x0a,
+    0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x01, 0x02, 0x02, 0x04, 0x12, 0x03, 0x17, 0x02, 0x0a, 0x0a, 0x0c,
+    0x0a, 0x05, 0x04, 0x01, 0x02, 0x02, 0x05, 0x12, 0x03, 0x17, 0x0b, 0x11, 0x0a, 0x0c, 0x0a, 0x05,
+    0x04, 0x01, 0x02, 0x02, 0x01, 0x12, 0x03, 0x17, 0x12, 0x19, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x01,
+    0x02, 0x02, 0x03, 0x12, 0x03, 0x17, 0x1c, 0x1d, 0x0a, 0x2f, 0x0a, 0x04, 0x04, 0x01, 0x02, 0x03,
+    0x12, 0x03, 0x19, 0x02, 0x11, 0x1a, 0x22, 0x20, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x20, 0x72, 0x65,
+    0x71, 0x75, 0x69, 0x72, 0x65, 0x73, 0x20, 0x61, 0x6e, 0x20, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x20,
+    0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x2e, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x01, 0x02,
+    0x03, 0x05, 0x12, 0x03, 0x19, 0x02, 0x06, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x01, 0x02, 0x03, 0x01,
+    0x12, 0x03, 0x19, 0x07, 0x0c, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x01, 0x02, 0x03, 0x03, 0x12, 0x03,
+    0x19, 0x0f, 0x10, 0x0a, 0x09, 0x0a, 0x01, 0x07, 0x12, 0x04, 0x1c, 0x00, 0x1e, 0x01, 0x0a, 0x09,
+    0x0a, 0x02, 0x07, 0x00, 0x12, 0x03, 0x1d, 0x02, 0x21, 0x0a, 0x0a, 0x0a, 0x03, 0x07, 0x00, 0x02,
+    0x12, 0x03, 0x1c, 0x07, 0x23, 0x0a, 0x0a, 0x0a, 0x03, 0x07, 0x00, 0x06, 0x12, 0x03, 0x1d, 0x02,
+    0x12, 0x0a, 0x0a, 0x0a, 0x03, 0x07, 0x00, 0x01, 0x12, 0x03, 0x1d, 0x13, 0x18, 0x0a, 0x0a, 0x0a,
+    0x03, 0x07, 0x00, 0x03, 0x12, 0x03, 0x1d, 0x1b, 0x20, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+    0x33,
+];
+// @@protoc_insertion_point(module)
@@ -7,6 +7,8 @@ pub struct CreateUserRequest {
     pub username: ::prost::alloc::string::String,
     #[prost(string, tag="2")]
     pub email: ::prost::alloc::string::String,
+    #[prost(string, tag="3")]
+    pub request_id: ::prost::alloc::string::String,
 }
 #[allow(clippy::derive_partial_eq_without_eq)]
 #[derive(Clone, PartialEq, ::prost::Message)]
@@ -43,11 +45,95 @@ pub struct UserData {
 }
 #[allow(clippy::derive_partial_eq_without_eq)]
 #[derive(Clone, PartialEq, ::prost::Message)]
-pub struct ErrorDetails {
+pub struct User {
     #[prost(string, tag="1")]
-    pub code: ::prost::alloc::string::String,
+    pub user_id: ::prost::alloc::string::String,
+    #[prost(string, tag="2")]
+    pub username: ::prost::alloc::string::String,
+    #[prost(string, tag="3")]
+    pub email: ::prost::alloc::string::String,
+    #[prost(enumeration="UserStatus", tag="4")]
+    pub status: i32,
+    #[prost(string, tag="5")]
+    pub etag: ::prost::alloc::string::String,
+}
+#[allow(clippy::derive_partial_eq_without_eq)]
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct GetUserRequest {
+    #[prost(string, tag="1")]
+    pub user_id: ::prost::alloc::string::String,
+}
+#[allow(clippy::derive_partial_eq_without_eq)]
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct GetUserResponse {
+    #[prost(message, optional, tag="1")]
+    pub user: ::core::option::Option<User>,
+}
+#[allow(clippy::derive_partial_eq_without_eq)]
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct UpdateUserRequest {
+    #[prost(message, optional, tag="1")]
+    pub user: ::core::option::Option<User>,
+    #[prost(message, optional, tag="2")]
+    pub update_mask: ::core::option::Option<::prost_types::FieldMask>,
+}
+#[allow(clippy::derive_partial_eq_without_eq)]
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct UpdateUserResponse {
+    #[prost(message, optional, tag="1")]
+    pub user: ::core::option::Option<User>,
+}
+#[allow(clippy::derive_partial_eq_without_eq)]
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct DeleteUserRequest {
+    #[prost(string, tag="1")]
+    pub user_id: ::prost::alloc::string::String,
+    #[prost(string, tag="2")]
+    pub etag: ::prost::alloc::string::String,
+}
+#[allow(clippy::derive_partial_eq_without_eq)]
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct DeleteUserResponse {
+    #[prost(message, optional, tag="1")]
+    pub user: ::core::option::Option<User>,
+}
+#[allow(clippy::derive_partial_eq_without_eq)]
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct ListUsersRequest {
+    #[prost(enumeration="UserStatus", tag="1")]
+    pub status: i32,
+    #[prost(int32, tag="2")]
+    pub page_size: i32,
+    #[prost(string, tag="3")]
+    pub page_token: ::prost::alloc::string::String,
+}
+#[allow(clippy::derive_partial_eq_without_eq)]
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct ListUsersResponse {
+    #[prost(message, repeated, tag="1")]
+    pub users: ::prost::alloc::vec::Vec<User>,
+    #[prost(string, tag="2")]
+    pub next_page_token: ::prost::alloc::string::String,
+}
+#[allow(clippy::derive_partial_eq_without_eq)]
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct ErrorDetails {
+    #[prost(enumeration="ErrorReason", tag="4")]
+    pub code: i32,
     #[prost(string, tag="2")]
     pub message: ::prost::alloc::string::String,
+    #[prost(message, repeated, tag="3")]
+    pub field_violations: ::prost::alloc::vec::Vec<FieldViolation>,
+}
+#[allow(clippy::derive_partial_eq_without_eq)]
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct FieldViolation {
+    #[prost(string, tag="1")]
+    pub field: ::prost::alloc::string::String,
+    #[prost(enumeration="ErrorReason", tag="4")]
+    pub code: i32,
+    #[prost(string, tag="3")]
+    pub message: ::prost::alloc::string::String,
 }
 #[derive(Clone, Copy, Debug, PartialEq, Eq, Hash, PartialOrd, Ord, ::prost::Enumeration)]
 #[repr(i32)]
@@ -78,133 +164,893 @@ impl UserStatus {
         }
     }
 }
+#[derive(Clone, Copy, Debug, PartialEq, Eq, Hash, PartialOrd, Ord, ::prost::Enumeration)]
+#[repr(i32)]
+pub enum ErrorReason {
+    Unspecified = 0,
+    ValidationFailed = 1,
+    ValidationEmptyUsername = 2,
+    ValidationUsernameTooLong = 3,
+    ValidationUsernameTooShort = 24,
+    ValidationInvalidUsername = 25,
+    ValidationUsernameBlocked = 26,
+    ValidationEmptyEmail = 4,
+    ValidationInvalidEmail = 5,
+    ValidationEmailDomainNotAllowed = 27,
+    ValidationEmailTooLong = 28,
+    ValidationConstraintViolated = 29,
+    DuplicateEmail = 6,
+    DuplicateUsername = 7,
+    UserNotFound = 8,
+    RequestIdReused = 9,
+    InvalidUserId = 10,
+    InvalidUserStatus = 11,
+    EmptyUpdateMask = 12,
+    InvalidUpdateMaskPath = 13,
+    InvalidPageSize = 14,
+    InvalidPageToken = 15,
+    ExpiredPageToken = 16,
+    InvalidEtag = 17,
+    StaleEtag = 18,
+    UserActive = 19,
+    DeadlineExceeded = 20,
+    RequestCanceled = 21,
+    RateLimitExceeded = 22,
+    InternalError = 23,
+}
+impl ErrorReason {
+    /// String value of the enum field names used in the ProtoBuf definition.
+    ///
+    /// The values are not transformed in any way and thus are considered stable
+    /// (if the ProtoBuf definition does not change) and safe for programmatic use.
+    pub fn as_str_name(&self) -> &'static str {
+        match self {
+            ErrorReason::Unspecified => "ERROR_REASON_UNSPECIFIED",
+            ErrorReason::ValidationFailed => "VALIDATION_FAILED",
+            ErrorReason::ValidationEmptyUsername => "VALIDATION_EMPTY_USERNAME",
+            ErrorReason::ValidationUsernameTooLong => "VALIDATION_USERNAME_TOO_LONG",
+            ErrorReason::ValidationUsernameTooShort => "VALIDATION_USERNAME_TOO_SHORT",
+            ErrorReason::ValidationInvalidUsername => "VALIDATION_INVALID_USERNAME",
+            ErrorReason::ValidationUsernameBlocked => "VALIDATION_USERNAME_BLOCKED",
+            ErrorReason::ValidationEmptyEmail => "VALIDATION_EMPTY_EMAIL",
+            ErrorReason::ValidationInvalidEmail => "VALIDATION_INVALID_EMAIL",
+            ErrorReason::ValidationEmailDomainNotAllowed => "VALIDATION_EMAIL_DOMAIN_NOT_ALLOWED",
+            ErrorReason::ValidationEmailTooLong => "VALIDATION_EMAIL_TOO_LONG",
+            ErrorReason::ValidationConstraintViolated => "VALIDATION_CONSTRAINT_VIOLATED",
+            ErrorReason::DuplicateEmail => "DUPLICATE_EMAIL",
+            ErrorReason::DuplicateUsername => "DUPLICATE_USERNAME",
+            ErrorReason::UserNotFound => "USER_NOT_FOUND",
+            ErrorReason::RequestIdReused => "REQUEST_ID_REUSED",
+            ErrorReason::InvalidUserId => "INVALID_USER_ID",
+            ErrorReason::InvalidUserStatus => "INVALID_USER_STATUS",
+            ErrorReason::EmptyUpdateMask => "EMPTY_UPDATE_MASK",
+            ErrorReason::InvalidUpdateMaskPath => "INVALID_UPDATE_MASK_PATH",
+            ErrorReason::InvalidPageSize => "INVALID_PAGE_SIZE",
+            ErrorReason::InvalidPageToken => "INVALID_PAGE_TOKEN",
+            ErrorReason::ExpiredPageToken => "EXPIRED_PAGE_TOKEN",
+            ErrorReason::InvalidEtag => "INVALID_ETAG",
+            ErrorReason::StaleEtag => "STALE_ETAG",
+            ErrorReason::UserActive => "USER_ACTIVE",
+            ErrorReason::DeadlineExceeded => "DEADLINE_EXCEEDED",
+            ErrorReason::RequestCanceled => "REQUEST_CANCELED",
+            ErrorReason::RateLimitExceeded => "RATE_LIMIT_EXCEEDED",
+            ErrorReason::InternalError => "INTERNAL_ERROR",
+        }
+    }
+    /// Creates an enum from field names used in the ProtoBuf definition.
+    pub fn from_str_name(value: &str) -> ::core::option::Option<Self> {
+        match value {
+            "ERROR_REASON_UNSPECIFIED" => Some(Self::Unspecified),
+            "VALIDATION_FAILED" => Some(Self::ValidationFailed),
+            "VALIDATION_EMPTY_USERNAME" => Some(Self::ValidationEmptyUsername),
+            "VALIDATION_USERNAME_TOO_LONG" => Some(Self::ValidationUsernameTooLong),
+            "VALIDATION_USERNAME_TOO_SHORT" => Some(Self::ValidationUsernameTooShort),
+            "VALIDATION_INVALID_USERNAME" => Some(Self::ValidationInvalidUsername),
+            "VALIDATION_USERNAME_BLOCKED" => Some(Self::ValidationUsernameBlocked),
+            "VALIDATION_EMPTY_EMAIL" => Some(Self::ValidationEmptyEmail),
+            "VALIDATION_INVALID_EMAIL" => Some(Self::ValidationInvalidEmail),
+            "VALIDATION_EMAIL_DOMAIN_NOT_ALLOWED" => Some(Self::ValidationEmailDomainNotAllowed),
+            "VALIDATION_EMAIL_TOO_LONG" => Some(Self::ValidationEmailTooLong),
+            "VALIDATION_CONSTRAINT_VIOLATED" => Some(Self::ValidationConstraintViolated),
+            "DUPLICATE_EMAIL" => Some(Self::DuplicateEmail),
+            "DUPLICATE_USERNAME" => Some(Self::DuplicateUsername),
+            "USER_NOT_FOUND" => Some(Self::UserNotFound),
+            "REQUEST_ID_REUSED" => Some(Self::RequestIdReused),
+            "INVALID_USER_ID" => Some(Self::InvalidUserId),
+            "INVALID_USER_STATUS" => Some(Self::InvalidUserStatus),
+            "EMPTY_UPDATE_MASK" => Some(Self::EmptyUpdateMask),
+            "INVALID_UPDATE_MASK_PATH" => Some(Self::InvalidUpdateMaskPath),
+            "INVALID_PAGE_SIZE" => Some(Self::InvalidPageSize),
+            "INVALID_PAGE_TOKEN" => Some(Self::InvalidPageToken),
+            "EXPIRED_PAGE_TOKEN" => Some(Self::ExpiredPageToken),
+            "INVALID_ETAG" => Some(Self::InvalidEtag),
+            "STALE_ETAG" => Some(Self::StaleEtag),
+            "USER_ACTIVE" => Some(Self::UserActive),
+            "DEADLINE_EXCEEDED" => Some(Self::DeadlineExceeded),
+            "REQUEST_CANCELED" => Some(Self::RequestCanceled),
+            "RATE_LIMIT_EXCEEDED" => Some(Self::RateLimitExceeded),
+            "INTERNAL_ERROR" => Some(Self::InternalError),
+            _ => None,
+        }
+    }
+}
 /// Encoded file descriptor set for the `hello_world` package
 pub const FILE_DESCRIPTOR_SET: &[u8] = &[
-    0x0a, 0xc9, 0x0f, 0x0a, 0x1b, 0x68, 0x65, 0x6c, 0x6c, 0x6f, 0x77, 0x6f, 0x72, 0x6c, 0x64, 0x2f,
+    0x0a, 0xe2, 0x60, 0x0a, 0x1b, 0x68, 0x65, 0x6c, 0x6c, 0x6f, 0x77, 0x6f, 0x72, 0x6c, 0x64, 0x2f,
     0x68, 0x65, 0x6c, 0x6c, 0x6f, 0x77, 0x6f, 0x72, 0x6c, 0x64, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-    0x12, 0x0b, 0x68, 0x65, 0x6c, 0x6c, 0x6f, 0x5f, 0x77, 0x6f, 0x72, 0x6c, 0x64, 0x22, 0x45, 0x0a,
-    0x11, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65,
-    0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
-    0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14,
-    0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65,
-    0x6d, 0x61, 0x69, 0x6c, 0x22, 0x5e, 0x0a, 0x12, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x55, 0x73,
-    0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73,
-    0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65,
-    0x72, 0x49, 0x64, 0x12, 0x2f, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20,
-    0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x68, 0x65, 0x6c, 0x6c, 0x6f, 0x5f, 0x77, 0x6f, 0x72, 0x6c,
-    0x64, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74,
-    0x61, 0x74, 0x75, 0x73, 0x22, 0x87, 0x01, 0x0a, 0x15, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x55,
-    0x73, 0x65, 0x72, 0x41, 0x6c, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31,
-    0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-    0x15, 0x2e, 0x68, 0x65, 0x6c, 0x6c, 0x6f, 0x5f, 0x77, 0x6f, 0x72, 0x6c, 0x64, 0x2e, 0x55, 0x73,
-    0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x48, 0x00, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73,
-    0x73, 0x12, 0x31, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
-    0x32, 0x19, 0x2e, 0x68, 0x65, 0x6c, 0x6c, 0x6f, 0x5f, 0x77, 0x6f, 0x72, 0x6c, 0x64, 0x2e, 0x45,
-    0x72, 0x72, 0x6f, 0x72, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x48, 0x00, 0x52, 0x05, 0x65,
-    0x72, 0x72, 0x6f, 0x72, 0x42, 0x08, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x54,
-    0x0a, 0x08, 0x55, 0x73, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73,
-    0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65,
-    0x72, 0x49, 0x64, 0x12, 0x2f, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20,
-    0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x68, 0x65, 0x6c, 0x6c, 0x6f, 0x5f, 0x77, 0x6f, 0x72, 0x6c,
-    0x64, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74,
-    0x61, 0x74, 0x75, 0x73, 0x22, 0x3c, 0x0a, 0x0c, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x44, 0x65, 0x74,
-    0x61, 0x69, 0x6c, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01,
-    0x28, 0x09, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73,
-    0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
-    0x67, 0x65, 0x2a, 0x5a, 0x0a, 0x0a, 0x55, 0x73, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
-    0x12, 0x1b, 0x0a, 0x17, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f,
-    0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x16, 0x0a,
-    0x12, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x41, 0x43, 0x54,
-    0x49, 0x56, 0x45, 0x10, 0x01, 0x12, 0x17, 0x0a, 0x13, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x53, 0x54,
-    0x41, 0x54, 0x55, 0x53, 0x5f, 0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x10, 0x02, 0x32, 0xb5,
-    0x01, 0x0a, 0x0b, 0x55, 0x73, 0x65, 0x72, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x4f,
-    0x0a, 0x0a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x12, 0x1e, 0x2e, 0x68,
-    0x65, 0x6c, 0x6c, 0x6f, 0x5f, 0x77, 0x6f, 0x72, 0x6c, 0x64, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74,
-    0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x68,
-    0x65, 0x6c, 0x6c, 0x6f, 0x5f, 0x77, 0x6f, 0x72, 0x6c, 0x64, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74,
-    0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
-    0x55, 0x0a, 0x0d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x41, 0x6c, 0x74,
-    0x12, 0x1e, 0x2e, 0x68, 0x65, 0x6c, 0x6c, 0x6f, 0x5f, 0x77, 0x6f, 0x72, 0x6c, 0x64, 0x2e, 0x43,
-    0x72, 0x65, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-    0x1a, 0x22, 0x2e, 0x68, 0x65, 0x6c, 0x6c, 0x6f, 0x5f, 0x77, 0x6f, 0x72, 0x6c, 0x64, 0x2e, 0x43,
-    0x72, 0x65, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x41, 0x6c, 0x74, 0x52, 0x65, 0x73, 0x70,
-    0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0xb4, 0x01, 0x0a, 0x0f, 0x63, 0x6f, 0x6d, 0x2e, 0x68,
-    0x65, 0x6c, 0x6c, 0x6f, 0x5f, 0x77, 0x6f, 0x72, 0x6c, 0x64, 0x42, 0x0f, 0x48, 0x65, 0x6c, 0x6c,
-    0x6f, 0x77, 0x6f, 0x72, 0x6c, 0x64, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x48, 0x67,
-    0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x6d, 0x69, 0x72, 0x73, 0x61,
-    0x6c, 0x61, 0x72, 0x73, 0x61, 0x66, 0x61, 0x65, 0x69, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2d,
-    0x65, 0x72, 0x72, 0x6f, 0x72, 0x2d, 0x68, 0x61, 0x6e, 0x64, 0x6c, 0x69, 0x6e, 0x67, 0x2f, 0x67,
-    0x6f, 0x2f, 0x68, 0x65, 0x6c, 0x6c, 0x6f, 0x77, 0x6f, 0x72, 0x6c, 0x64, 0x3b, 0x68, 0x65, 0x6c,
-    0x6c, 0x6f, 0x77, 0x6f, 0x72, 0x6c, 0x64, 0xa2, 0x02, 0x03, 0x48, 0x58, 0x58, 0xaa, 0x02, 0x0a,
-    0x48, 0x65, 0x6c, 0x6c, 0x6f, 0x57, 0x6f, 0x72, 0x6c, 0x64, 0xca, 0x02, 0x0a, 0x48, 0x65, 0x6c,
-    0x6c, 0x6f, 0x57, 0x6f, 0x72, 0x6c, 0x64, 0xe2, 0x02, 0x16, 0x48, 0x65, 0x6c, 0x6c, 0x6f, 0x57,
-    0x6f, 0x72, 0x6c, 0x64, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
-    0xea, 0x02, 0x0a, 0x48, 0x65, 0x6c, 0x6c, 0x6f, 0x57, 0x6f, 0x72, 0x6c, 0x64, 0x4a, 0x84, 0x08,
-    0x0a, 0x06, 0x12, 0x04, 0x00, 0x00, 0x2c, 0x01, 0x0a, 0x08, 0x0a, 0x01, 0x0c, 0x12, 0x03, 0x00,
-    0x00, 0x12, 0x0a, 0x08, 0x0a, 0x01, 0x02, 0x12, 0x03, 0x02, 0x00, 0x14, 0x0a, 0x08, 0x0a, 0x01,
-    0x08, 0x12, 0x03, 0x04, 0x00, 0x5f, 0x0a, 0x09, 0x0a, 0x02, 0x08, 0x0b, 0x12, 0x03, 0x04, 0x00,
-    0x5f, 0x0a, 0x0a, 0x0a, 0x02, 0x06, 0x00, 0x12, 0x04, 0x08, 0x00, 0x0b, 0x01, 0x0a, 0x0a, 0x0a,
-    0x03, 0x06, 0x00, 0x01, 0x12, 0x03, 0x08, 0x08, 0x13, 0x0a, 0x0b, 0x0a, 0x04, 0x06, 0x00, 0x02,
-    0x00, 0x12, 0x03, 0x09, 0x02, 0x43, 0x0a, 0x0c, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x00, 0x01, 0x12,
-    0x03, 0x09, 0x06, 0x10, 0x0a, 0x0c, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x00, 0x02, 0x12, 0x03, 0x09,
-    0x11, 0x22, 0x0a, 0x0c, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x00, 0x03, 0x12, 0x03, 0x09, 0x2d, 0x3f,
-    0x0a, 0x0b, 0x0a, 0x04, 0x06, 0x00, 0x02, 0x01, 0x12, 0x03, 0x0a, 0x02, 0x49, 0x0a, 0x0c, 0x0a,
-    0x05, 0x06, 0x00, 0x02, 0x01, 0x01, 0x12, 0x03, 0x0a, 0x06, 0x13, 0x0a, 0x0c, 0x0a, 0x05, 0x06,
-    0x00, 0x02, 0x01, 0x02, 0x12, 0x03, 0x0a, 0x14, 0x25, 0x0a, 0x0c, 0x0a, 0x05, 0x06, 0x00, 0x02,
-    0x01, 0x03, 0x12, 0x03, 0x0a, 0x30, 0x45, 0x0a, 0x0a, 0x0a, 0x02, 0x04, 0x00, 0x12, 0x04, 0x0d,
-    0x00, 0x10, 0x01, 0x0a, 0x0a, 0x0a, 0x03, 0x04, 0x00, 0x01, 0x12, 0x03, 0x0d, 0x08, 0x19, 0x0a,
-    0x0b, 0x0a, 0x04, 0x04, 0x00, 0x02, 0x00, 0x12, 0x03, 0x0e, 0x02, 0x16, 0x0a, 0x0c, 0x0a, 0x05,
-    0x04, 0x00, 0x02, 0x00, 0x05, 0x12, 0x03, 0x0e, 0x02, 0x08, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x00,
-    0x02, 0x00, 0x01, 0x12, 0x03, 0x0e, 0x09, 0x11, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x00, 0x02, 0x00,
-    0x03, 0x12, 0x03, 0x0e, 0x14, 0x15, 0x0a, 0x0b, 0x0a, 0x04, 0x04, 0x00, 0x02, 0x01, 0x12, 0x03,
-    0x0f, 0x02, 0x13, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x00, 0x02, 0x01, 0x05, 0x12, 0x03, 0x0f, 0x02,
-    0x08, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x00, 0x02, 0x01, 0x01, 0x12, 0x03, 0x0f, 0x09, 0x0e, 0x0a,
-    0x0c, 0x0a, 0x05, 0x04, 0x00, 0x02, 0x01, 0x03, 0x12, 0x03, 0x0f, 0x11, 0x12, 0x0a, 0x0a, 0x0a,
-    0x02, 0x04, 0x01, 0x12, 0x04, 0x12, 0x00, 0x15, 0x01, 0x0a, 0x0a, 0x0a, 0x03, 0x04, 0x01, 0x01,
-    0x12, 0x03, 0x12, 0x08, 0x1a, 0x0a, 0x0b, 0x0a, 0x04, 0x04, 0x01, 0x02, 0x00, 0x12, 0x03, 0x13,
-    0x02, 0x15, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x01, 0x02, 0x00, 0x05, 0x12, 0x03, 0x13, 0x02, 0x08,
-    0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x01, 0x02, 0x00, 0x01, 0x12, 0x03, 0x13, 0x09, 0x10, 0x0a, 0x0c,
-    0x0a, 0x05, 0x04, 0x01, 0x02, 0x00, 0x03, 0x12, 0x03, 0x13, 0x13, 0x14, 0x0a, 0x0b, 0x0a, 0x04,
-    0x04, 0x01, 0x02, 0x01, 0x12, 0x03, 0x14, 0x02, 0x18, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x01, 0x02,
-    0x01, 0x06, 0x12, 0x03, 0x14, 0x02, 0x0c, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x01, 0x02, 0x01, 0x01,
-    0x12, 0x03, 0x14, 0x0d, 0x13, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x01, 0x02, 0x01, 0x03, 0x12, 0x03,
-    0x14, 0x16, 0x17, 0x0a, 0x0a, 0x0a, 0x02, 0x04, 0x02, 0x12, 0x04, 0x17, 0x00, 0x1c, 0x01, 0x0a,
-    0x0a, 0x0a, 0x03, 0x04, 0x02, 0x01, 0x12, 0x03, 0x17, 0x08, 0x1d, 0x0a, 0x0c, 0x0a, 0x04, 0x04,
-    0x02, 0x08, 0x00, 0x12, 0x04, 0x18, 0x02, 0x1b, 0x03, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x02, 0x08,
-    0x00, 0x01, 0x12, 0x03, 0x18, 0x08, 0x0e, 0x0a, 0x0b, 0x0a, 0x04, 0x04, 0x02, 0x02, 0x00, 0x12,
-    0x03, 0x19, 0x04, 0x19, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x02, 0x02, 0x00, 0x06, 0x12, 0x03, 0x19,
-    0x04, 0x0c, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x02, 0x02, 0x00, 0x01, 0x12, 0x03, 0x19, 0x0d, 0x14,
-    0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x02, 0x02, 0x00, 0x03, 0x12, 0x03, 0x19, 0x17, 0x18, 0x0a, 0x0b,
-    0x0a, 0x04, 0x04, 0x02, 0x02, 0x01, 0x12, 0x03, 0x1a, 0x04, 0x1b, 0x0a, 0x0c, 0x0a, 0x05, 0x04,
-    0x02, 0x02, 0x01, 0x06, 0x12, 0x03, 0x1a, 0x04, 0x10, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x02, 0x02,
-    0x01, 0x01, 0x12, 0x03, 0x1a, 0x11, 0x16, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x02, 0x02, 0x01, 0x03,
-    0x12, 0x03, 0x1a, 0x19, 0x1a, 0x0a, 0x0a, 0x0a, 0x02, 0x04, 0x03, 0x12, 0x04, 0x1e, 0x00, 0x21,
-    0x01, 0x0a, 0x0a, 0x0a, 0x03, 0x04, 0x03, 0x01, 0x12, 0x03, 0x1e, 0x08, 0x10, 0x0a, 0x0b, 0x0a,
-    0x04, 0x04, 0x03, 0x02, 0x00, 0x12, 0x03, 0x1f, 0x02, 0x15, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x03,
-    0x02, 0x00, 0x05, 0x12, 0x03, 0x1f, 0x02, 0x08, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x03, 0x02, 0x00,
-    0x01, 0x12, 0x03, 0x1f, 0x09, 0x10, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x03, 0x02, 0x00, 0x03, 0x12,
-    0x03, 0x1f, 0x13, 0x14, 0x0a, 0x0b, 0x0a, 0x04, 0x04, 0x03, 0x02, 0x01, 0x12, 0x03, 0x20, 0x02,
-    0x18, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x03, 0x02, 0x01, 0x06, 0x12, 0x03, 0x20, 0x02, 0x0c, 0x0a,
-    0x0c, 0x0a, 0x05, 0x04, 0x03, 0x02, 0x01, 0x01, 0x12, 0x03, 0x20, 0x0d, 0x13, 0x0a, 0x0c, 0x0a,
-    0x05, 0x04, 0x03, 0x02, 0x01, 0x03, 0x12, 0x03, 0x20, 0x16, 0x17, 0x0a, 0x0a, 0x0a, 0x02, 0x04,
-    0x04, 0x12, 0x04, 0x23, 0x00, 0x26, 0x01, 0x0a, 0x0a, 0x0a, 0x03, 0x04, 0x04, 0x01, 0x12, 0x03,
-    0x23, 0x08, 0x14, 0x0a, 0x0b, 0x0a, 0x04, 0x04, 0x04, 0x02, 0x00, 0x12, 0x03, 0x24, 0x02, 0x12,
-    0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x04, 0x02, 0x00, 0x05, 0x12, 0x03, 0x24, 0x02, 0x08, 0x0a, 0x0c,
-    0x0a, 0x05, 0x04, 0x04, 0x02, 0x00, 0x01, 0x12, 0x03, 0x24, 0x09, 0x0d, 0x0a, 0x0c, 0x0a, 0x05,
-    0x04, 0x04, 0x02, 0x00, 0x03, 0x12, 0x03, 0x24, 0x10, 0x11, 0x0a, 0x0b, 0x0a, 0x04, 0x04, 0x04,
-    0x02, 0x01, 0x12, 0x03, 0x25, 0x02, 0x15, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x04, 0x02, 0x01, 0x05,
-    0x12, 0x03, 0x25, 0x02, 0x08, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x04, 0x02, 0x01, 0x01, 0x12, 0x03,
-    0x25, 0x09, 0x10, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x04, 0x02, 0x01, 0x03, 0x12, 0x03, 0x25, 0x13,
-    0x14, 0x0a, 0x0a, 0x0a, 0x02, 0x05, 0x00, 0x12, 0x04, 0x28, 0x00, 0x2c, 0x01, 0x0a, 0x0a, 0x0a,
-    0x03, 0x05, 0x00, 0x01, 0x12, 0x03, 0x28, 0x05, 0x0f, 0x0a, 0x0b, 0x0a, 0x04, 0x05, 0x00, 0x02,
-    0x00, 0x12, 0x03, 0x29, 0x02, 0x1e, 0x0a, 0x0c, 0x0a, 0x05, 0x05, 0x00, 0x02, 0x00, 0x01, 0x12,
-    0x03, 0x29, 0x02, 0x19, 0x0a, 0x0c, 0x0a, 0x05, 0x05, 0x00, 0x02, 0x00, 0x02, 0x12, 0x03, 0x29,
-    0x1c, 0x1d, 0x0a, 0x0b, 0x0a, 0x04, 0x05, 0x00, 0x02, 0x01, 0x12, 0x03, 0x2a, 0x02, 0x19, 0x0a,
-    0x0c, 0x0a, 0x05, 0x05, 0x00, 0x02, 0x01, 0x01, 0x12, 0x03, 0x2a, 0x02, 0x14, 0x0a, 0x0c, 0x0a,
-    0x05, 0x05, 0x00, 0x02, 0x01, 0x02, 0x12, 0x03, 0x2a, 0x17, 0x18, 0x0a, 0x0b, 0x0a, 0x04, 0x05,
-    0x00, 0x02, 0x02, 0x12, 0x03, 0x2b, 0x02, 0x1a, 0x0a, 0x0c, 0x0a, 0x05, 0x05, 0x00, 0x02, 0x02,
-    0x01, 0x12, 0x03, 0x2b, 0x02, 0x15, 0x0a, 0x0c, 0x0a, 0x05, 0x05, 0x00, 0x02, 0x02, 0x02, 0x12,
-    0x03, 0x2b, 0x18, 0x19, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+    0x12, 0x0b, 0x68, 0x65, 0x6c, 0x6c, 0x6f, 0x5f, 0x77, 0x6f, 0x72, 0x6c, 0x64, 0x1a, 0x19, 0x65,
+    0x72, 0x72, 0x6f, 0x72, 0x73, 0x70, 0x65, 0x63, 0x2f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x70,
+    0x65, 0x63, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x19, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73,
+    0x70, 0x65, 0x63, 0x2f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x70, 0x65, 0x63, 0x2e, 0x70, 0x72,
+    0x6f, 0x74, 0x6f, 0x1a, 0x20, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74,
+    0x6f, 0x62, 0x75, 0x66, 0x2f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x5f, 0x6d, 0x61, 0x73, 0x6b, 0x2e,
+    0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x86, 0x01, 0x0a, 0x11, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+    0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2d, 0x0a, 0x08, 0x75,
+    0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x11, 0xc2,
+    0xc1, 0x18, 0x0d, 0x08, 0x01, 0x12, 0x09, 0x10, 0x32, 0x1a, 0x05, 0x5e, 0x5c, 0x53, 0x2b, 0x24,
+    0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x23, 0x0a, 0x05, 0x65, 0x6d,
+    0x61, 0x69, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0d, 0xc2, 0xc1, 0x18, 0x09, 0x08,
+    0x01, 0x12, 0x05, 0x10, 0xfe, 0x01, 0x20, 0x01, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12,
+    0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20,
+    0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x22, 0x5e,
+    0x0a, 0x12, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70,
+    0x6f, 0x6e, 0x73, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18,
+    0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x2f, 0x0a,
+    0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e,
+    0x68, 0x65, 0x6c, 0x6c, 0x6f, 0x5f, 0x77, 0x6f, 0x72, 0x6c, 0x64, 0x2e, 0x55, 0x73, 0x65, 0x72,
+    0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x87,
+    0x01, 0x0a, 0x15, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x41, 0x6c, 0x74,
+    0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63,
+    0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x68, 0x65, 0x6c, 0x6c,
+    0x6f, 0x5f, 0x77, 0x6f, 0x72, 0x6c, 0x64, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61,
+    0x48, 0x00, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x31, 0x0a, 0x05, 0x65,
+    0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x68, 0x65, 0x6c,
+    0x6c, 0x6f, 0x5f, 0x77, 0x6f, 0x72, 0x6c, 0x64, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x44, 0x65,
+    0x74, 0x61, 0x69, 0x6c, 0x73, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x42, 0x08,
+    0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x54, 0x0a, 0x08, 0x55, 0x73, 0x65, 0x72,
+    0x44, 0x61, 0x74, 0x61, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18,
+    0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x2f, 0x0a,
+    0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e,
+    0x68, 0x65, 0x6c, 0x6c, 0x6f, 0x5f, 0x77, 0x6f, 0x72, 0x6c, 0x64, 0x2e, 0x55, 0x73, 0x65, 0x72,
+    0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x96,
+    0x01, 0x0a, 0x04, 0x55, 0x73, 0x65, 0x72, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f,
+    0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64,
+    0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
+    0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05,
+    0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61,
+    0x69, 0x6c, 0x12, 0x2f, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x04, 0x20, 0x01,
+    0x28, 0x0e, 0x32, 0x17, 0x2e, 0x68, 0x65, 0x6c, 0x6c, 0x6f, 0x5f, 0x77, 0x6f, 0x72, 0x6c, 0x64,
+    0x2e, 0x55, 0x73, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61,
+    0x74, 0x75, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x65, 0x74, 0x61, 0x67, 0x18, 0x05, 0x20, 0x01, 0x28,
+    0x09, 0x52, 0x04, 0x65, 0x74, 0x61, 0x67, 0x22, 0x29, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x55, 0x73,
+    0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65,
+    0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72,
+    0x49, 0x64, 0x22, 0x38, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73,
+    0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x25, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x01, 0x20,
+    0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x68, 0x65, 0x6c, 0x6c, 0x6f, 0x5f, 0x77, 0x6f, 0x72, 0x6c,
+    0x64, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x22, 0x77, 0x0a, 0x11,
+    0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+    0x74, 0x12, 0x25, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+    0x11, 0x2e, 0x68, 0x65, 0x6c, 0x6c, 0x6f, 0x5f, 0x77, 0x6f, 0x72, 0x6c, 0x64, 0x2e, 0x55, 0x73,
+    0x65, 0x72, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x12, 0x3b, 0x0a, 0x0b, 0x75, 0x70, 0x64, 0x61,
+    0x74, 0x65, 0x5f, 0x6d, 0x61, 0x73, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+    0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+    0x46, 0x69, 0x65, 0x6c, 0x64, 0x4d, 0x61, 0x73, 0x6b, 0x52, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74,
+    0x65, 0x4d, 0x61, 0x73, 0x6b, 0x22, 0x3b, 0x0a, 0x12, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55,
+    0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x25, 0x0a, 0x04, 0x75,
+    0x73, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x68, 0x65, 0x6c, 0x6c,
+    0x6f, 0x5f, 0x77, 0x6f, 0x72, 0x6c, 0x64, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x04, 0x75, 0x73,
+    0x65, 0x72, 0x22, 0x40, 0x0a, 0x11, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72,
+    0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f,
+    0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64,
+    0x12, 0x12, 0x0a, 0x04, 0x65, 0x74, 0x61, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+    0x65, 0x74, 0x61, 0x67, 0x22, 0x3b, 0x0a, 0x12, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x55, 0x73,
+    0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x25, 0x0a, 0x04, 0x75, 0x73,
+    0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x68, 0x65, 0x6c, 0x6c, 0x6f,
+    0x5f, 0x77, 0x6f, 0x72, 0x6c, 0x64, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x04, 0x75, 0x73, 0x65,
+    0x72, 0x22, 0x7f, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65,
+    0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2f, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
+    0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x68, 0x65, 0x6c, 0x6c, 0x6f, 0x5f, 0x77, 0x6f,
+    0x72, 0x6c, 0x64, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06,
+    0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73,
+    0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53,
+    0x69, 0x7a, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65,
+    0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b,
+    0x65, 0x6e, 0x22, 0x64, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52,
+    0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x27, 0x0a, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73,
+    0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x68, 0x65, 0x6c, 0x6c, 0x6f, 0x5f, 0x77,
+    0x6f, 0x72, 0x6c, 0x64, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73,
+    0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f,
+    0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50,
+    0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0xa4, 0x01, 0x0a, 0x0c, 0x45, 0x72, 0x72,
+    0x6f, 0x72, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x12, 0x2c, 0x0a, 0x04, 0x63, 0x6f, 0x64,
+    0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x68, 0x65, 0x6c, 0x6c, 0x6f, 0x5f,
+    0x77, 0x6f, 0x72, 0x6c, 0x64, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x65, 0x61, 0x73, 0x6f,
+    0x6e, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
+    0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+    0x65, 0x12, 0x46, 0x0a, 0x10, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x5f, 0x76, 0x69, 0x6f, 0x6c, 0x61,
+    0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x68, 0x65,
+    0x6c, 0x6c, 0x6f, 0x5f, 0x77, 0x6f, 0x72, 0x6c, 0x64, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x56,
+    0x69, 0x6f, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x56,
+    0x69, 0x6f, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x4a, 0x04, 0x08, 0x01, 0x10, 0x02, 0x22,
+    0x74, 0x0a, 0x0e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x56, 0x69, 0x6f, 0x6c, 0x61, 0x74, 0x69, 0x6f,
+    0x6e, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+    0x52, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x2c, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18,
+    0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x68, 0x65, 0x6c, 0x6c, 0x6f, 0x5f, 0x77, 0x6f,
+    0x72, 0x6c, 0x64, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x52,
+    0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+    0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x4a,
+    0x04, 0x08, 0x02, 0x10, 0x03, 0x2a, 0x5a, 0x0a, 0x0a, 0x55, 0x73, 0x65, 0x72, 0x53, 0x74, 0x61,
+    0x74, 0x75, 0x73, 0x12, 0x1b, 0x0a, 0x17, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x53, 0x54, 0x41, 0x54,
+    0x55, 0x53, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00,
+    0x12, 0x16, 0x0a, 0x12, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f,
+    0x41, 0x43, 0x54, 0x49, 0x56, 0x45, 0x10, 0x01, 0x12, 0x17, 0x0a, 0x13, 0x55, 0x53, 0x45, 0x52,
+    0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x10,
+    0x02, 0x2a, 0xf5, 0x10, 0x0a, 0x0b, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x65, 0x61, 0x73, 0x6f,
+    0x6e, 0x12, 0x1c, 0x0a, 0x18, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f,
+    0x4e, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12,
+    0x32, 0x0a, 0x11, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x46, 0x41,
+    0x49, 0x4c, 0x45, 0x44, 0x10, 0x01, 0x1a, 0x1b, 0xa2, 0xbb, 0x18, 0x17, 0x08, 0x03, 0x12, 0x11,
+    0x49, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x20, 0x75, 0x73, 0x65, 0x72, 0x20, 0x64, 0x61, 0x74,
+    0x61, 0x28, 0x01, 0x12, 0x49, 0x0a, 0x19, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x41, 0x54, 0x49, 0x4f,
+    0x4e, 0x5f, 0x45, 0x4d, 0x50, 0x54, 0x59, 0x5f, 0x55, 0x53, 0x45, 0x52, 0x4e, 0x41, 0x4d, 0x45,
+    0x10, 0x02, 0x1a, 0x2a, 0xa2, 0xbb, 0x18, 0x26, 0x08, 0x03, 0x12, 0x18, 0x55, 0x73, 0x65, 0x72,
+    0x6e, 0x61, 0x6d, 0x65, 0x20, 0x63, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x20, 0x62, 0x65, 0x20, 0x65,
+    0x6d, 0x70, 0x74, 0x79, 0x1a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x53,
+    0x0a, 0x1c, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x55, 0x53, 0x45,
+    0x52, 0x4e, 0x41, 0x4d, 0x45, 0x5f, 0x54, 0x4f, 0x4f, 0x5f, 0x4c, 0x4f, 0x4e, 0x47, 0x10, 0x03,
+    0x1a, 0x31, 0xa2, 0xbb, 0x18, 0x2d, 0x08, 0x03, 0x12, 0x1f, 0x55, 0x73, 0x65, 0x72, 0x6e, 0x61,
+    0x6d, 0x65, 0x20, 0x65, 0x78, 0x63, 0x65, 0x65, 0x64, 0x73, 0x20, 0x6d, 0x61, 0x78, 0x69, 0x6d,
+    0x75, 0x6d, 0x20, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x1a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e,
+    0x61, 0x6d, 0x65, 0x12, 0x55, 0x0a, 0x1d, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x41, 0x54, 0x49, 0x4f,
+    0x4e, 0x5f, 0x55, 0x53, 0x45, 0x52, 0x4e, 0x41, 0x4d, 0x45, 0x5f, 0x54, 0x4f, 0x4f, 0x5f, 0x53,
+    0x48, 0x4f, 0x52, 0x54, 0x10, 0x18, 0x1a, 0x32, 0xa2, 0xbb, 0x18, 0x2e, 0x08, 0x03, 0x12, 0x20,
+    0x55, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x20, 0x69, 0x73, 0x20, 0x62, 0x65, 0x6c, 0x6f,
+    0x77, 0x20, 0x6d, 0x69, 0x6e, 0x69, 0x6d, 0x75, 0x6d, 0x20, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68,
+    0x1a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x57, 0x0a, 0x1b, 0x56, 0x41,
+    0x4c, 0x49, 0x44, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44,
+    0x5f, 0x55, 0x53, 0x45, 0x52, 0x4e, 0x41, 0x4d, 0x45, 0x10, 0x19, 0x1a, 0x36, 0xa2, 0xbb, 0x18,
+    0x32, 0x08, 0x03, 0x12, 0x24, 0x55, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x20, 0x63, 0x6f,
+    0x6e, 0x74, 0x61, 0x69, 0x6e, 0x73, 0x20, 0x69, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x20, 0x63,
+    0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x73, 0x1a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e,
+    0x61, 0x6d, 0x65, 0x12, 0x4a, 0x0a, 0x1b, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x41, 0x54, 0x49, 0x4f,
+    0x4e, 0x5f, 0x55, 0x53, 0x45, 0x52, 0x4e, 0x41, 0x4d, 0x45, 0x5f, 0x42, 0x4c, 0x4f, 0x43, 0x4b,
+    0x45, 0x44, 0x10, 0x1a, 0x1a, 0x29, 0xa2, 0xbb, 0x18, 0x25, 0x08, 0x03, 0x12, 0x17, 0x55, 0x73,
+    0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x20, 0x69, 0x73, 0x20, 0x6e, 0x6f, 0x74, 0x20, 0x61, 0x6c,
+    0x6c, 0x6f, 0x77, 0x65, 0x64, 0x1a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+    0x40, 0x0a, 0x16, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x45, 0x4d,
+    0x50, 0x54, 0x59, 0x5f, 0x45, 0x4d, 0x41, 0x49, 0x4c, 0x10, 0x04, 0x1a, 0x24, 0xa2, 0xbb, 0x18,
+    0x20, 0x08, 0x03, 0x12, 0x15, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x20, 0x63, 0x61, 0x6e, 0x6e, 0x6f,
+    0x74, 0x20, 0x62, 0x65, 0x20, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x05, 0x65, 0x6d, 0x61, 0x69,
+    0x6c, 0x12, 0x41, 0x0a, 0x18, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f,
+    0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x45, 0x4d, 0x41, 0x49, 0x4c, 0x10, 0x05, 0x1a,
+    0x23, 0xa2, 0xbb, 0x18, 0x1f, 0x08, 0x03, 0x12, 0x14, 0x49, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64,
+    0x20, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x20, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x1a, 0x05, 0x65,
+    0x6d, 0x61, 0x69, 0x6c, 0x12, 0x53, 0x0a, 0x23, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x41, 0x54, 0x49,
+    0x4f, 0x4e, 0x5f, 0x45, 0x4d, 0x41, 0x49, 0x4c, 0x5f, 0x44, 0x4f, 0x4d, 0x41, 0x49, 0x4e, 0x5f,
+    0x4e, 0x4f, 0x54, 0x5f, 0x41, 0x4c, 0x4c, 0x4f, 0x57, 0x45, 0x44, 0x10, 0x1b, 0x1a, 0x2a, 0xa2,
+    0xbb, 0x18, 0x26, 0x08, 0x03, 0x12, 0x1b, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x20, 0x64, 0x6f, 0x6d,
+    0x61, 0x69, 0x6e, 0x20, 0x69, 0x73, 0x20, 0x6e, 0x6f, 0x74, 0x20, 0x61, 0x6c, 0x6c, 0x6f, 0x77,
+    0x65, 0x64, 0x1a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x4a, 0x0a, 0x19, 0x56, 0x41, 0x4c,
+    0x49, 0x44, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x45, 0x4d, 0x41, 0x49, 0x4c, 0x5f, 0x54, 0x4f,
+    0x4f, 0x5f, 0x4c, 0x4f, 0x4e, 0x47, 0x10, 0x1c, 0x1a, 0x2b, 0xa2, 0xbb, 0x18, 0x27, 0x08, 0x03,
+    0x12, 0x1c, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x20, 0x65, 0x78, 0x63, 0x65, 0x65, 0x64, 0x73, 0x20,
+    0x6d, 0x61, 0x78, 0x69, 0x6d, 0x75, 0x6d, 0x20, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x1a, 0x05,
+    0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x5e, 0x0a, 0x1e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x41, 0x54,
+    0x49, 0x4f, 0x4e, 0x5f, 0x43, 0x4f, 0x4e, 0x53, 0x54, 0x52, 0x41, 0x49, 0x4e, 0x54, 0x5f, 0x56,
+    0x49, 0x4f, 0x4c, 0x41, 0x54, 0x45, 0x44, 0x10, 0x1d, 0x1a, 0x3a, 0xa2, 0xbb, 0x18, 0x36, 0x08,
+    0x03, 0x12, 0x26, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x20, 0x76, 0x69, 0x6f, 0x6c, 0x61, 0x74, 0x65,
+    0x73, 0x20, 0x61, 0x20, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x74, 0x20, 0x6f,
+    0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x41, 0x50, 0x49, 0x22, 0x0a, 0x63, 0x6f, 0x6e, 0x73, 0x74,
+    0x72, 0x61, 0x69, 0x6e, 0x74, 0x12, 0x3f, 0x0a, 0x0f, 0x44, 0x55, 0x50, 0x4c, 0x49, 0x43, 0x41,
+    0x54, 0x45, 0x5f, 0x45, 0x4d, 0x41, 0x49, 0x4c, 0x10, 0x06, 0x1a, 0x2a, 0xa2, 0xbb, 0x18, 0x26,
+    0x08, 0x06, 0x12, 0x14, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x20, 0x61, 0x6c, 0x72, 0x65, 0x61, 0x64,
+    0x79, 0x20, 0x69, 0x6e, 0x20, 0x75, 0x73, 0x65, 0x1a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x22,
+    0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x4b, 0x0a, 0x12, 0x44, 0x55, 0x50, 0x4c, 0x49, 0x43,
+    0x41, 0x54, 0x45, 0x5f, 0x55, 0x53, 0x45, 0x52, 0x4e, 0x41, 0x4d, 0x45, 0x10, 0x07, 0x1a, 0x33,
+    0xa2, 0xbb, 0x18, 0x2f, 0x08, 0x06, 0x12, 0x17, 0x55, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65,
+    0x20, 0x61, 0x6c, 0x72, 0x65, 0x61, 0x64, 0x79, 0x20, 0x69, 0x6e, 0x20, 0x75, 0x73, 0x65, 0x1a,
+    0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e,
+    0x61, 0x6d, 0x65, 0x12, 0x2a, 0x0a, 0x0e, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x4e, 0x4f, 0x54, 0x5f,
+    0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x08, 0x1a, 0x16, 0xa2, 0xbb, 0x18, 0x12, 0x08, 0x05, 0x12,
+    0x0e, 0x55, 0x73, 0x65, 0x72, 0x20, 0x6e, 0x6f, 0x74, 0x20, 0x66, 0x6f, 0x75, 0x6e, 0x64, 0x12,
+    0x6a, 0x0a, 0x11, 0x52, 0x45, 0x51, 0x55, 0x45, 0x53, 0x54, 0x5f, 0x49, 0x44, 0x5f, 0x52, 0x45,
+    0x55, 0x53, 0x45, 0x44, 0x10, 0x09, 0x1a, 0x53, 0xa2, 0xbb, 0x18, 0x4f, 0x08, 0x03, 0x12, 0x33,
+    0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x20, 0x49, 0x44, 0x20, 0x77, 0x61, 0x73, 0x20, 0x61,
+    0x6c, 0x72, 0x65, 0x61, 0x64, 0x79, 0x20, 0x75, 0x73, 0x65, 0x64, 0x20, 0x66, 0x6f, 0x72, 0x20,
+    0x61, 0x20, 0x64, 0x69, 0x66, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x74, 0x20, 0x72, 0x65, 0x71, 0x75,
+    0x65, 0x73, 0x74, 0x1a, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x22,
+    0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x12, 0x45, 0x0a, 0x0f, 0x49,
+    0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x49, 0x44, 0x10, 0x0a,
+    0x1a, 0x30, 0xa2, 0xbb, 0x18, 0x2c, 0x08, 0x03, 0x12, 0x16, 0x55, 0x73, 0x65, 0x72, 0x20, 0x49,
+    0x44, 0x20, 0x6d, 0x75, 0x73, 0x74, 0x20, 0x62, 0x65, 0x20, 0x61, 0x20, 0x55, 0x55, 0x49, 0x44,
+    0x1a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x22, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f,
+    0x69, 0x64, 0x12, 0x44, 0x0a, 0x13, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x55, 0x53,
+    0x45, 0x52, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x10, 0x0b, 0x1a, 0x2b, 0xa2, 0xbb, 0x18,
+    0x27, 0x08, 0x03, 0x12, 0x13, 0x49, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x20, 0x75, 0x73, 0x65,
+    0x72, 0x20, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x1a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+    0x22, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x56, 0x0a, 0x11, 0x45, 0x4d, 0x50, 0x54,
+    0x59, 0x5f, 0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x5f, 0x4d, 0x41, 0x53, 0x4b, 0x10, 0x0c, 0x1a,
+    0x3f, 0xa2, 0xbb, 0x18, 0x3b, 0x08, 0x03, 0x12, 0x2a, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x20,
+    0x6d, 0x61, 0x73, 0x6b, 0x20, 0x6d, 0x75, 0x73, 0x74, 0x20, 0x6c, 0x69, 0x73, 0x74, 0x20, 0x74,
+    0x68, 0x65, 0x20, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x20, 0x74, 0x6f, 0x20, 0x75, 0x70, 0x64,
+    0x61, 0x74, 0x65, 0x1a, 0x0b, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x73, 0x6b,
+    0x12, 0x69, 0x0a, 0x18, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x55, 0x50, 0x44, 0x41,
+    0x54, 0x45, 0x5f, 0x4d, 0x41, 0x53, 0x4b, 0x5f, 0x50, 0x41, 0x54, 0x48, 0x10, 0x0d, 0x1a, 0x4b,
+    0xa2, 0xbb, 0x18, 0x47, 0x08, 0x03, 0x12, 0x30, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x20, 0x6d,
+    0x61, 0x73, 0x6b, 0x20, 0x6c, 0x69, 0x73, 0x74, 0x73, 0x20, 0x61, 0x20, 0x66, 0x69, 0x65, 0x6c,
+    0x64, 0x20, 0x74, 0x68, 0x61, 0x74, 0x20, 0x63, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x20, 0x62, 0x65,
+    0x20, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x1a, 0x0b, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
+    0x5f, 0x6d, 0x61, 0x73, 0x6b, 0x22, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x53, 0x0a, 0x11, 0x49,
+    0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x50, 0x41, 0x47, 0x45, 0x5f, 0x53, 0x49, 0x5a, 0x45,
+    0x10, 0x0e, 0x1a, 0x3c, 0xa2, 0xbb, 0x18, 0x38, 0x08, 0x03, 0x12, 0x1e, 0x50, 0x61, 0x67, 0x65,
+    0x20, 0x73, 0x69, 0x7a, 0x65, 0x20, 0x6d, 0x75, 0x73, 0x74, 0x20, 0x6e, 0x6f, 0x74, 0x20, 0x62,
+    0x65, 0x20, 0x6e, 0x65, 0x67, 0x61, 0x74, 0x69, 0x76, 0x65, 0x1a, 0x09, 0x70, 0x61, 0x67, 0x65,
+    0x5f, 0x73, 0x69, 0x7a, 0x65, 0x22, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65,
+    0x12, 0x40, 0x0a, 0x12, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x50, 0x41, 0x47, 0x45,
+    0x5f, 0x54, 0x4f, 0x4b, 0x45, 0x4e, 0x10, 0x0f, 0x1a, 0x28, 0xa2, 0xbb, 0x18, 0x24, 0x08, 0x03,
+    0x12, 0x12, 0x49, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x20, 0x70, 0x61, 0x67, 0x65, 0x20, 0x74,
+    0x6f, 0x6b, 0x65, 0x6e, 0x1a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+    0x30, 0x01, 0x12, 0x44, 0x0a, 0x12, 0x45, 0x58, 0x50, 0x49, 0x52, 0x45, 0x44, 0x5f, 0x50, 0x41,
+    0x47, 0x45, 0x5f, 0x54, 0x4f, 0x4b, 0x45, 0x4e, 0x10, 0x10, 0x1a, 0x2c, 0xa2, 0xbb, 0x18, 0x28,
+    0x08, 0x03, 0x12, 0x16, 0x50, 0x61, 0x67, 0x65, 0x20, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x20, 0x68,
+    0x61, 0x73, 0x20, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x1a, 0x0a, 0x70, 0x61, 0x67, 0x65,
+    0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x30, 0x01, 0x12, 0x32, 0x0a, 0x0c, 0x49, 0x4e, 0x56, 0x41,
+    0x4c, 0x49, 0x44, 0x5f, 0x45, 0x54, 0x41, 0x47, 0x10, 0x11, 0x1a, 0x20, 0xa2, 0xbb, 0x18, 0x1c,
+    0x08, 0x03, 0x12, 0x0c, 0x49, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x20, 0x65, 0x74, 0x61, 0x67,
+    0x1a, 0x04, 0x65, 0x74, 0x61, 0x67, 0x22, 0x04, 0x65, 0x74, 0x61, 0x67, 0x12, 0x38, 0x0a, 0x0a,
+    0x53, 0x54, 0x41, 0x4c, 0x45, 0x5f, 0x45, 0x54, 0x41, 0x47, 0x10, 0x12, 0x1a, 0x28, 0xa2, 0xbb,
+    0x18, 0x24, 0x08, 0x0a, 0x12, 0x1e, 0x55, 0x73, 0x65, 0x72, 0x20, 0x77, 0x61, 0x73, 0x20, 0x6d,
+    0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x20, 0x63, 0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72, 0x65,
+    0x6e, 0x74, 0x6c, 0x79, 0x30, 0x01, 0x12, 0x40, 0x0a, 0x0b, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x41,
+    0x43, 0x54, 0x49, 0x56, 0x45, 0x10, 0x13, 0x1a, 0x2f, 0xa2, 0xbb, 0x18, 0x2b, 0x08, 0x09, 0x12,
+    0x1e, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x20, 0x75, 0x73, 0x65, 0x72, 0x73, 0x20, 0x63, 0x61,
+    0x6e, 0x6e, 0x6f, 0x74, 0x20, 0x62, 0x65, 0x20, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x22,
+    0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x12, 0x3a, 0x0a, 0x11, 0x44, 0x45, 0x41, 0x44,
+    0x4c, 0x49, 0x4e, 0x45, 0x5f, 0x45, 0x58, 0x43, 0x45, 0x45, 0x44, 0x45, 0x44, 0x10, 0x14, 0x1a,
+    0x23, 0xa2, 0xbb, 0x18, 0x1f, 0x08, 0x04, 0x12, 0x19, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+    0x20, 0x64, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x20, 0x65, 0x78, 0x63, 0x65, 0x65, 0x64,
+    0x65, 0x64, 0x30, 0x01, 0x12, 0x30, 0x0a, 0x10, 0x52, 0x45, 0x51, 0x55, 0x45, 0x53, 0x54, 0x5f,
+    0x43, 0x41, 0x4e, 0x43, 0x45, 0x4c, 0x45, 0x44, 0x10, 0x15, 0x1a, 0x1a, 0xa2, 0xbb, 0x18, 0x16,
+    0x08, 0x01, 0x12, 0x10, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x20, 0x63, 0x61, 0x6e, 0x63,
+    0x65, 0x6c, 0x65, 0x64, 0x30, 0x01, 0x12, 0x36, 0x0a, 0x13, 0x52, 0x41, 0x54, 0x45, 0x5f, 0x4c,
+    0x49, 0x4d, 0x49, 0x54, 0x5f, 0x45, 0x58, 0x43, 0x45, 0x45, 0x44, 0x45, 0x44, 0x10, 0x16, 0x1a,
+    0x1d, 0xa2, 0xbb, 0x18, 0x19, 0x08, 0x08, 0x12, 0x13, 0x52, 0x61, 0x74, 0x65, 0x20, 0x6c, 0x69,
+    0x6d, 0x69, 0x74, 0x20, 0x65, 0x78, 0x63, 0x65, 0x65, 0x64, 0x65, 0x64, 0x30, 0x01, 0x12, 0x2c,
+    0x0a, 0x0e, 0x49, 0x4e, 0x54, 0x45, 0x52, 0x4e, 0x41, 0x4c, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52,
+    0x10, 0x17, 0x1a, 0x18, 0xa2, 0xbb, 0x18, 0x14, 0x08, 0x0d, 0x12, 0x0e, 0x49, 0x6e, 0x74, 0x65,
+    0x72, 0x6e, 0x61, 0x6c, 0x20, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x30, 0x01, 0x1a, 0x1b, 0xa2, 0xbb,
+    0x18, 0x17, 0x68, 0x65, 0x6c, 0x6c, 0x6f, 0x5f, 0x77, 0x6f, 0x72, 0x6c, 0x64, 0x2e, 0x55, 0x73,
+    0x65, 0x72, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x32, 0xed, 0x03, 0x0a, 0x0b, 0x55, 0x73,
+    0x65, 0x72, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x4f, 0x0a, 0x0a, 0x43, 0x72, 0x65,
+    0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x12, 0x1e, 0x2e, 0x68, 0x65, 0x6c, 0x6c, 0x6f, 0x5f,
+    0x77, 0x6f, 0x72, 0x6c, 0x64, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72,
+    0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x68, 0x65, 0x6c, 0x6c, 0x6f, 0x5f,
+    0x77, 0x6f, 0x72, 0x6c, 0x64, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72,
+    0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x55, 0x0a, 0x0d, 0x43, 0x72,
+    0x65, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x41, 0x6c, 0x74, 0x12, 0x1e, 0x2e, 0x68, 0x65,
+    0x6c, 0x6c, 0x6f, 0x5f, 0x77, 0x6f, 0x72, 0x6c, 0x64, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+    0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x68, 0x65,
+    0x6c, 0x6c, 0x6f, 0x5f, 0x77, 0x6f, 0x72, 0x6c, 0x64, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+    0x55, 0x73, 0x65, 0x72, 0x41, 0x6c, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+    0x00, 0x12, 0x46, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x12, 0x1b, 0x2e, 0x68,
+    0x65, 0x6c, 0x6c, 0x6f, 0x5f, 0x77, 0x6f, 0x72, 0x6c, 0x64, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73,
+    0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x68, 0x65, 0x6c, 0x6c,
+    0x6f, 0x5f, 0x77, 0x6f, 0x72, 0x6c, 0x64, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52,
+    0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4f, 0x0a, 0x0a, 0x55, 0x70, 0x64,
+    0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x12, 0x1e, 0x2e, 0x68, 0x65, 0x6c, 0x6c, 0x6f, 0x5f,
+    0x77, 0x6f, 0x72, 0x6c, 0x64, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72,
+    0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x68, 0x65, 0x6c, 0x6c, 0x6f, 0x5f,
+    0x77, 0x6f, 0x72, 0x6c, 0x64, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72,
+    0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4f, 0x0a, 0x0a, 0x44, 0x65,
+    0x6c, 0x65, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x12, 0x1e, 0x2e, 0x68, 0x65, 0x6c, 0x6c, 0x6f,
+    0x5f, 0x77, 0x6f, 0x72, 0x6c, 0x64, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x55, 0x73, 0x65,
+    0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x68, 0x65, 0x6c, 0x6c, 0x6f,
+    0x5f, 0x77, 0x6f, 0x72, 0x6c, 0x64, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x55, 0x73, 0x65,
+    0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4c, 0x0a, 0x09, 0x4c,
+    0x69, 0x73, 0x74, 0x55, 0x73, 0x65, 0x72, 0x73, 0x12, 0x1d, 0x2e, 0x68, 0x65, 0x6c, 0x6c, 0x6f,
+    0x5f, 0x77, 0x6f, 0x72, 0x6c, 0x64, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x73, 0x65, 0x72, 0x73,
+    0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x68, 0x65, 0x6c, 0x6c, 0x6f, 0x5f,
+    0x77, 0x6f, 0x72, 0x6c, 0x64, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52,
+    0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0xc2, 0x01, 0x0a, 0x0f, 0x63, 0x6f,
+    0x6d, 0x2e, 0x68, 0x65, 0x6c, 0x6c, 0x6f, 0x5f, 0x77, 0x6f, 0x72, 0x6c, 0x64, 0x42, 0x0f, 0x48,
+    0x65, 0x6c, 0x6c, 0x6f, 0x77, 0x6f, 0x72, 0x6c, 0x64, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01,
+    0x5a, 0x56, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x6d, 0x69,
+    0x72, 0x73, 0x61, 0x6c, 0x61, 0x72, 0x73, 0x61, 0x66, 0x61, 0x65, 0x69, 0x2f, 0x70, 0x72, 0x6f,
+    0x74, 0x6f, 0x2d, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x2d, 0x68, 0x61, 0x6e, 0x64, 0x6c, 0x69, 0x6e,
+    0x67, 0x2f, 0x61, 0x75, 0x74, 0x6f, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x64, 0x2f,
+    0x67, 0x6f, 0x2f, 0x68, 0x65, 0x6c, 0x6c, 0x6f, 0x77, 0x6f, 0x72, 0x6c, 0x64, 0x3b, 0x68, 0x65,
+    0x6c, 0x6c, 0x6f, 0x77, 0x6f, 0x72, 0x6c, 0x64, 0xa2, 0x02, 0x03, 0x48, 0x58, 0x58, 0xaa, 0x02,
+    0x0a, 0x48, 0x65, 0x6c, 0x6c, 0x6f, 0x57, 0x6f, 0x72, 0x6c, 0x64, 0xca, 0x02, 0x0a, 0x48, 0x65,
+    0x6c, 0x6c, 0x6f, 0x57, 0x6f, 0x72, 0x6c, 0x64, 0xe2, 0x02, 0x16, 0x48, 0x65, 0x6c, 0x6c, 0x6f,
+    0x57, 0x6f, 0x72, 0x6c, 0x64, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
+    0x61, 0xea, 0x02, 0x0a, 0x48, 0x65, 0x6c, 0x6c, 0x6f, 0x57, 0x6f, 0x72, 0x6c, 0x64, 0x4a, 0xcc,
+    0x3c, 0x0a, 0x07, 0x12, 0x05, 0x00, 0x00, 0x96, 0x02, 0x01, 0x0a, 0x08, 0x0a, 0x01, 0x0c, 0x12,
+    0x03, 0x00, 0x00, 0x12, 0x0a, 0x08, 0x0a, 0x01, 0x02, 0x12, 0x03, 0x02, 0x00, 0x14, 0x0a, 0x09,
+    0x0a, 0x02, 0x03, 0x00, 0x12, 0x03, 0x04, 0x00, 0x23, 0x0a, 0x09, 0x0a, 0x02, 0x03, 0x01, 0x12,
+    0x03, 0x05, 0x00, 0x23, 0x0a, 0x09, 0x0a, 0x02, 0x03, 0x02, 0x12, 0x03, 0x06, 0x00, 0x2a, 0x0a,
+    0x08, 0x0a, 0x01, 0x08, 0x12, 0x03, 0x08, 0x00, 0x6d, 0x0a, 0x09, 0x0a, 0x02, 0x08, 0x0b, 0x12,
+    0x03, 0x08, 0x00, 0x6d, 0x0a, 0x0a, 0x0a, 0x02, 0x06, 0x00, 0x12, 0x04, 0x0c, 0x00, 0x13, 0x01,
+    0x0a, 0x0a, 0x0a, 0x03, 0x06, 0x00, 0x01, 0x12, 0x03, 0x0c, 0x08, 0x13, 0x0a, 0x0b, 0x0a, 0x04,
+    0x06, 0x00, 0x02, 0x00, 0x12, 0x03, 0x0d, 0x02, 0x43, 0x0a, 0x0c, 0x0a, 0x05, 0x06, 0x00, 0x02,
+    0x00, 0x01, 0x12, 0x03, 0x0d, 0x06, 0x10, 0x0a, 0x0c, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x00, 0x02,
+    0x12, 0x03, 0x0d, 0x11, 0x22, 0x0a, 0x0c, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x00, 0x03, 0x12, 0x03,
+    0x0d, 0x2d, 0x3f, 0x0a, 0x0b, 0x0a, 0x04, 0x06, 0x00, 0x02, 0x01, 0x12, 0x03, 0x0e, 0x02, 0x49,
+    0x0a, 0x0c, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x01, 0x01, 0x12, 0x03, 0x0e, 0x06, 0x13, 0x0a, 0x0c,
+    0x0a, 0x05, 0x06, 0x00, 0x02, 0x01, 0x02, 0x12, 0x03, 0x0e, 0x14, 0x25, 0x0a, 0x0c, 0x0a, 0x05,
+    0x06, 0x00, 0x02, 0x01, 0x03, 0x12, 0x03, 0x0e, 0x30, 0x45, 0x0a, 0x0b, 0x0a, 0x04, 0x06, 0x00,
+    0x02, 0x02, 0x12, 0x03, 0x0f, 0x02, 0x3a, 0x0a, 0x0c, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x02, 0x01,
+    0x12, 0x03, 0x0f, 0x06, 0x0d, 0x0a, 0x0c, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x02, 0x02, 0x12, 0x03,
+    0x0f, 0x0e, 0x1c, 0x0a, 0x0c, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x02, 0x03, 0x12, 0x03, 0x0f, 0x27,
+    0x36, 0x0a, 0x0b, 0x0a, 0x04, 0x06, 0x00, 0x02, 0x03, 0x12, 0x03, 0x10, 0x02, 0x43, 0x0a, 0x0c,
+    0x0a, 0x05, 0x06, 0x00, 0x02, 0x03, 0x01, 0x12, 0x03, 0x10, 0x06, 0x10, 0x0a, 0x0c, 0x0a, 0x05,
+    0x06, 0x00, 0x02, 0x03, 0x02, 0x12, 0x03, 0x10, 0x11, 0x22, 0x0a, 0x0c, 0x0a, 0x05, 0x06, 0x00,
+    0x02, 0x03, 0x03, 0x12, 0x03, 0x10, 0x2d, 0x3f, 0x0a, 0x0b, 0x0a, 0x04, 0x06, 0x00, 0x02, 0x04,
+    0x12, 0x03, 0x11, 0x02, 0x43, 0x0a, 0x0c, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x04, 0x01, 0x12, 0x03,
+    0x11, 0x06, 0x10, 0x0a, 0x0c, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x04, 0x02, 0x12, 0x03, 0x11, 0x11,
+    0x22, 0x0a, 0x0c, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x04, 0x03, 0x12, 0x03, 0x11, 0x2d, 0x3f, 0x0a,
+    0x0b, 0x0a, 0x04, 0x06, 0x00, 0x02, 0x05, 0x12, 0x03, 0x12, 0x02, 0x40, 0x0a, 0x0c, 0x0a, 0x05,
+    0x06, 0x00, 0x02, 0x05, 0x01, 0x12, 0x03, 0x12, 0x06, 0x0f, 0x0a, 0x0c, 0x0a, 0x05, 0x06, 0x00,
+    0x02, 0x05, 0x02, 0x12, 0x03, 0x12, 0x10, 0x20, 0x0a, 0x0c, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x05,
+    0x03, 0x12, 0x03, 0x12, 0x2b, 0x3c, 0x0a, 0x0a, 0x0a, 0x02, 0x04, 0x00, 0x12, 0x04, 0x15, 0x00,
+    0x22, 0x01, 0x0a, 0x0a, 0x0a, 0x03, 0x04, 0x00, 0x01, 0x12, 0x03, 0x15, 0x08, 0x19, 0x0a, 0x9c,
+    0x01, 0x0a, 0x04, 0x04, 0x00, 0x02, 0x00, 0x12, 0x04, 0x18, 0x02, 0x1b, 0x05, 0x1a, 0x8d, 0x01,
+    0x20, 0x54, 0x68, 0x65, 0x20, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x74, 0x73,
+    0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x41, 0x50, 0x49, 0x2c, 0x20, 0x77, 0x68, 0x69,
+    0x63, 0x68, 0x20, 0x65, 0x76, 0x65, 0x72, 0x79, 0x20, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x20,
+    0x65, 0x6e, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x73, 0x2e, 0x20, 0x54, 0x68, 0x65, 0x20, 0x76, 0x61,
+    0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x0a, 0x20, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x20,
+    0x6f, 0x66, 0x20, 0x61, 0x20, 0x64, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x20,
+    0x6d, 0x61, 0x79, 0x20, 0x74, 0x69, 0x67, 0x68, 0x74, 0x65, 0x6e, 0x20, 0x74, 0x68, 0x65, 0x6d,
+    0x2c, 0x20, 0x65, 0x2e, 0x67, 0x2e, 0x20, 0x74, 0x6f, 0x20, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x65,
+    0x72, 0x20, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x2e, 0x0a, 0x0a, 0x0c, 0x0a,
+    0x05, 0x04, 0x00, 0x02, 0x00, 0x05, 0x12, 0x03, 0x18, 0x02, 0x08, 0x0a, 0x0c, 0x0a, 0x05, 0x04,
+    0x00, 0x02, 0x00, 0x01, 0x12, 0x03, 0x18, 0x09, 0x11, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x00, 0x02,
+    0x00, 0x03, 0x12, 0x03, 0x18, 0x14, 0x15, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x00, 0x02, 0x00, 0x08,
+    0x12, 0x04, 0x18, 0x16, 0x1b, 0x04, 0x0a, 0x10, 0x0a, 0x08, 0x04, 0x00, 0x02, 0x00, 0x08, 0x98,
+    0x88, 0x03, 0x12, 0x04, 0x18, 0x17, 0x1b, 0x03, 0x0a, 0x10, 0x0a, 0x09, 0x04, 0x00, 0x02, 0x00,
+    0x08, 0x98, 0x88, 0x03, 0x01, 0x12, 0x03, 0x19, 0x04, 0x12, 0x0a, 0x10, 0x0a, 0x09, 0x04, 0x00,
+    0x02, 0x00, 0x08, 0x98, 0x88, 0x03, 0x02, 0x12, 0x03, 0x1a, 0x04, 0x2c, 0x0a, 0x11, 0x0a, 0x0a,
+    0x04, 0x00, 0x02, 0x00, 0x08, 0x98, 0x88, 0x03, 0x02, 0x02, 0x12, 0x03, 0x1a, 0x0d, 0x18, 0x0a,
+    0x11, 0x0a, 0x0a, 0x04, 0x00, 0x02, 0x00, 0x08, 0x98, 0x88, 0x03, 0x02, 0x03, 0x12, 0x03, 0x1a,
+    0x1a, 0x2b, 0x0a, 0x44, 0x0a, 0x04, 0x04, 0x00, 0x02, 0x01, 0x12, 0x04, 0x1d, 0x02, 0x20, 0x05,
+    0x1a, 0x36, 0x20, 0x4e, 0x6f, 0x20, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x20, 0x69, 0x73,
+    0x20, 0x6c, 0x6f, 0x6e, 0x67, 0x65, 0x72, 0x20, 0x74, 0x68, 0x61, 0x6e, 0x20, 0x32, 0x35, 0x34,
+    0x20, 0x63, 0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x73, 0x20, 0x28, 0x52, 0x46, 0x43,
+    0x20, 0x35, 0x33, 0x32, 0x31, 0x29, 0x2e, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x00, 0x02, 0x01,
+    0x05, 0x12, 0x03, 0x1d, 0x02, 0x08, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x00, 0x02, 0x01, 0x01, 0x12,
+    0x03, 0x1d, 0x09, 0x0e, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x00, 0x02, 0x01, 0x03, 0x12, 0x03, 0x1d,
+    0x11, 0x12, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x00, 0x02, 0x01, 0x08, 0x12, 0x04, 0x1d, 0x13, 0x20,
+    0x04, 0x0a, 0x10, 0x0a, 0x08, 0x04, 0x00, 0x02, 0x01, 0x08, 0x98, 0x88, 0x03, 0x12, 0x04, 0x1d,
+    0x14, 0x20, 0x03, 0x0a, 0x10, 0x0a, 0x09, 0x04, 0x00, 0x02, 0x01, 0x08, 0x98, 0x88, 0x03, 0x01,
+    0x12, 0x03, 0x1e, 0x04, 0x12, 0x0a, 0x10, 0x0a, 0x09, 0x04, 0x00, 0x02, 0x01, 0x08, 0x98, 0x88,
+    0x03, 0x02, 0x12, 0x03, 0x1f, 0x04, 0x27, 0x0a, 0x11, 0x0a, 0x0a, 0x04, 0x00, 0x02, 0x01, 0x08,
+    0x98, 0x88, 0x03, 0x02, 0x02, 0x12, 0x03, 0x1f, 0x0d, 0x19, 0x0a, 0x11, 0x0a, 0x0a, 0x04, 0x00,
+    0x02, 0x01, 0x08, 0x98, 0x88, 0x03, 0x02, 0x04, 0x12, 0x03, 0x1f, 0x1b, 0x26, 0x0a, 0x0b, 0x0a,
+    0x04, 0x04, 0x00, 0x02, 0x02, 0x12, 0x03, 0x21, 0x02, 0x18, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x00,
+    0x02, 0x02, 0x05, 0x12, 0x03, 0x21, 0x02, 0x08, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x00, 0x02, 0x02,
+    0x01, 0x12, 0x03, 0x21, 0x09, 0x13, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x00, 0x02, 0x02, 0x03, 0x12,
+    0x03, 0x21, 0x16, 0x17, 0x0a, 0x0a, 0x0a, 0x02, 0x04, 0x01, 0x12, 0x04, 0x24, 0x00, 0x27, 0x01,
+    0x0a, 0x0a, 0x0a, 0x03, 0x04, 0x01, 0x01, 0x12, 0x03, 0x24, 0x08, 0x1a, 0x0a, 0x0b, 0x0a, 0x04,
+    0x04, 0x01, 0x02, 0x00, 0x12, 0x03, 0x25, 0x02, 0x15, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x01, 0x02,
+    0x00, 0x05, 0x12, 0x03, 0x25, 0x02, 0x08, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x01, 0x02, 0x00, 0x01,
+    0x12, 0x03, 0x25, 0x09, 0x10, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x01, 0x02, 0x00, 0x03, 0x12, 0x03,
+    0x25, 0x13, 0x14, 0x0a, 0x0b, 0x0a, 0x04, 0x04, 0x01, 0x02, 0x01, 0x12, 0x03, 0x26, 0x02, 0x18,
+    0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x01, 0x02, 0x01, 0x06, 0x12, 0x03, 0x26, 0x02, 0x0c, 0x0a, 0x0c,
+    0x0a, 0x05, 0x04, 0x01, 0x02, 0x01, 0x01, 0x12, 0x03, 0x26, 0x0d, 0x13, 0x0a, 0x0c, 0x0a, 0x05,
+    0x04, 0x01, 0x02, 0x01, 0x03, 0x12, 0x03, 0x26, 0x16, 0x17, 0x0a, 0x0a, 0x0a, 0x02, 0x04, 0x02,
+    0x12, 0x04, 0x29, 0x00, 0x2e, 0x01, 0x0a, 0x0a, 0x0a, 0x03, 0x04, 0x02, 0x01, 0x12, 0x03, 0x29,
+    0x08, 0x1d, 0x0a, 0x0c, 0x0a, 0x04, 0x04, 0x02, 0x08, 0x00, 0x12, 0x04, 0x2a, 0x02, 0x2d, 0x03,
+    0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x02, 0x08, 0x00, 0x01, 0x12, 0x03, 0x2a, 0x08, 0x0e, 0x0a, 0x0b,
+    0x0a, 0x04, 0x04, 0x02, 0x02, 0x00, 0x12, 0x03, 0x2b, 0x04, 0x19, 0x0a, 0x0c, 0x0a, 0x05, 0x04,
+    0x02, 0x02, 0x00, 0x06, 0x12, 0x03, 0x2b, 0x04, 0x0c, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x02, 0x02,
+    0x00, 0x01, 0x12, 0x03, 0x2b, 0x0d, 0x14, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x02, 0x02, 0x00, 0x03,
+    0x12, 0x03, 0x2b, 0x17, 0x18, 0x0a, 0x0b, 0x0a, 0x04, 0x04, 0x02, 0x02, 0x01, 0x12, 0x03, 0x2c,
+    0x04, 0x1b, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x02, 0x02, 0x01, 0x06, 0x12, 0x03, 0x2c, 0x04, 0x10,
+    0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x02, 0x02, 0x01, 0x01, 0x12, 0x03, 0x2c, 0x11, 0x16, 0x0a, 0x0c,
+    0x0a, 0x05, 0x04, 0x02, 0x02, 0x01, 0x03, 0x12, 0x03, 0x2c, 0x19, 0x1a, 0x0a, 0x0a, 0x0a, 0x02,
+    0x04, 0x03, 0x12, 0x04, 0x30, 0x00, 0x33, 0x01, 0x0a, 0x0a, 0x0a, 0x03, 0x04, 0x03, 0x01, 0x12,
+    0x03, 0x30, 0x08, 0x10, 0x0a, 0x0b, 0x0a, 0x04, 0x04, 0x03, 0x02, 0x00, 0x12, 0x03, 0x31, 0x02,
+    0x15, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x03, 0x02, 0x00, 0x05, 0x12, 0x03, 0x31, 0x02, 0x08, 0x0a,
+    0x0c, 0x0a, 0x05, 0x04, 0x03, 0x02, 0x00, 0x01, 0x12, 0x03, 0x31, 0x09, 0x10, 0x0a, 0x0c, 0x0a,
+    0x05, 0x04, 0x03, 0x02, 0x00, 0x03, 0x12, 0x03, 0x31, 0x13, 0x14, 0x0a, 0x0b, 0x0a, 0x04, 0x04,
+    0x03, 0x02, 0x01, 0x12, 0x03, 0x32, 0x02, 0x18, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x03, 0x02, 0x01,
+    0x06, 0x12, 0x03, 0x32, 0x02, 0x0c, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x03, 0x02, 0x01, 0x01, 0x12,
+    0x03, 0x32, 0x0d, 0x13, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x03, 0x02, 0x01, 0x03, 0x12, 0x03, 0x32,
+    0x16, 0x17, 0x0a, 0x0a, 0x0a, 0x02, 0x04, 0x04, 0x12, 0x04, 0x35, 0x00, 0x3b, 0x01, 0x0a, 0x0a,
+    0x0a, 0x03, 0x04, 0x04, 0x01, 0x12, 0x03, 0x35, 0x08, 0x0c, 0x0a, 0x0b, 0x0a, 0x04, 0x04, 0x04,
+    0x02, 0x00, 0x12, 0x03, 0x36, 0x02, 0x15, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x04, 0x02, 0x00, 0x05,
+    0x12, 0x03, 0x36, 0x02, 0x08, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x04, 0x02, 0x00, 0x01, 0x12, 0x03,
+    0x36, 0x09, 0x10, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x04, 0x02, 0x00, 0x03, 0x12, 0x03, 0x36, 0x13,
+    0x14, 0x0a, 0x0b, 0x0a, 0x04, 0x04, 0x04, 0x02, 0x01, 0x12, 0x03, 0x37, 0x02, 0x16, 0x0a, 0x0c,
+    0x0a, 0x05, 0x04, 0x04, 0x02, 0x01, 0x05, 0x12, 0x03, 0x37, 0x02, 0x08, 0x0a, 0x0c, 0x0a, 0x05,
+    0x04, 0x04, 0x02, 0x01, 0x01, 0x12, 0x03, 0x37, 0x09, 0x11, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x04,
+    0x02, 0x01, 0x03, 0x12, 0x03, 0x37, 0x14, 0x15, 0x0a, 0x0b, 0x0a, 0x04, 0x04, 0x04, 0x02, 0x02,
+    0x12, 0x03, 0x38, 0x02, 0x13, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x04, 0x02, 0x02, 0x05, 0x12, 0x03,
+    0x38, 0x02, 0x08, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x04, 0x02, 0x02, 0x01, 0x12, 0x03, 0x38, 0x09,
+    0x0e, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x04, 0x02, 0x02, 0x03, 0x12, 0x03, 0x38, 0x11, 0x12, 0x0a,
+    0x0b, 0x0a, 0x04, 0x04, 0x04, 0x02, 0x03, 0x12, 0x03, 0x39, 0x02, 0x18, 0x0a, 0x0c, 0x0a, 0x05,
+    0x04, 0x04, 0x02, 0x03, 0x06, 0x12, 0x03, 0x39, 0x02, 0x0c, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x04,
+    0x02, 0x03, 0x01, 0x12, 0x03, 0x39, 0x0d, 0x13, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x04, 0x02, 0x03,
+    0x03, 0x12, 0x03, 0x39, 0x16, 0x17, 0x0a, 0x0b, 0x0a, 0x04, 0x04, 0x04, 0x02, 0x04, 0x12, 0x03,
+    0x3a, 0x02, 0x12, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x04, 0x02, 0x04, 0x05, 0x12, 0x03, 0x3a, 0x02,
+    0x08, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x04, 0x02, 0x04, 0x01, 0x12, 0x03, 0x3a, 0x09, 0x0d, 0x0a,
+    0x0c, 0x0a, 0x05, 0x04, 0x04, 0x02, 0x04, 0x03, 0x12, 0x03, 0x3a, 0x10, 0x11, 0x0a, 0x0a, 0x0a,
+    0x02, 0x04, 0x05, 0x12, 0x04, 0x3d, 0x00, 0x3f, 0x01, 0x0a, 0x0a, 0x0a, 0x03, 0x04, 0x05, 0x01,
+    0x12, 0x03, 0x3d, 0x08, 0x16, 0x0a, 0x0b, 0x0a, 0x04, 0x04, 0x05, 0x02, 0x00, 0x12, 0x03, 0x3e,
+    0x02, 0x15, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x05, 0x02, 0x00, 0x05, 0x12, 0x03, 0x3e, 0x02, 0x08,
+    0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x05, 0x02, 0x00, 0x01, 0x12, 0x03, 0x3e, 0x09, 0x10, 0x0a, 0x0c,
+    0x0a, 0x05, 0x04, 0x05, 0x02, 0x00, 0x03, 0x12, 0x03, 0x3e, 0x13, 0x14, 0x0a, 0x0a, 0x0a, 0x02,
+    0x04, 0x06, 0x12, 0x04, 0x41, 0x00, 0x43, 0x01, 0x0a, 0x0a, 0x0a, 0x03, 0x04, 0x06, 0x01, 0x12,
+    0x03, 0x41, 0x08, 0x17, 0x0a, 0x0b, 0x0a, 0x04, 0x04, 0x06, 0x02, 0x00, 0x12, 0x03, 0x42, 0x02,
+    0x10, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x06, 0x02, 0x00, 0x06, 0x12, 0x03, 0x42, 0x02, 0x06, 0x0a,
+    0x0c, 0x0a, 0x05, 0x04, 0x06, 0x02, 0x00, 0x01, 0x12, 0x03, 0x42, 0x07, 0x0b, 0x0a, 0x0c, 0x0a,
+    0x05, 0x04, 0x06, 0x02, 0x00, 0x03, 0x12, 0x03, 0x42, 0x0e, 0x0f, 0x0a, 0x0a, 0x0a, 0x02, 0x04,
+    0x07, 0x12, 0x04, 0x45, 0x00, 0x48, 0x01, 0x0a, 0x0a, 0x0a, 0x03, 0x04, 0x07, 0x01, 0x12, 0x03,
+    0x45, 0x08, 0x19, 0x0a, 0x0b, 0x0a, 0x04, 0x04, 0x07, 0x02, 0x00, 0x12, 0x03, 0x46, 0x02, 0x10,
+    0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x07, 0x02, 0x00, 0x06, 0x12, 0x03, 0x46, 0x02, 0x06, 0x0a, 0x0c,
+    0x0a, 0x05, 0x04, 0x07, 0x02, 0x00, 0x01, 0x12, 0x03, 0x46, 0x07, 0x0b, 0x0a, 0x0c, 0x0a, 0x05,
+    0x04, 0x07, 0x02, 0x00, 0x03, 0x12, 0x03, 0x46, 0x0e, 0x0f, 0x0a, 0x0b, 0x0a, 0x04, 0x04, 0x07,
+    0x02, 0x01, 0x12, 0x03, 0x47, 0x02, 0x2c, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x07, 0x02, 0x01, 0x06,
+    0x12, 0x03, 0x47, 0x02, 0x1b, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x07, 0x02, 0x01, 0x01, 0x12, 0x03,
+    0x47, 0x1c, 0x27, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x07, 0x02, 0x01, 0x03, 0x12, 0x03, 0x47, 0x2a,
+    0x2b, 0x0a, 0x0a, 0x0a, 0x02, 0x04, 0x08, 0x12, 0x04, 0x4a, 0x00, 0x4c, 0x01, 0x0a, 0x0a, 0x0a,
+    0x03, 0x04, 0x08, 0x01, 0x12, 0x03, 0x4a, 0x08, 0x1a, 0x0a, 0x0b, 0x0a, 0x04, 0x04, 0x08, 0x02,
+    0x00, 0x12, 0x03, 0x4b, 0x02, 0x10, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x08, 0x02, 0x00, 0x06, 0x12,
+    0x03, 0x4b, 0x02, 0x06, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x08, 0x02, 0x00, 0x01, 0x12, 0x03, 0x4b,
+    0x07, 0x0b, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x08, 0x02, 0x00, 0x03, 0x12, 0x03, 0x4b, 0x0e, 0x0f,
+    0x0a, 0x0a, 0x0a, 0x02, 0x04, 0x09, 0x12, 0x04, 0x4e, 0x00, 0x51, 0x01, 0x0a, 0x0a, 0x0a, 0x03,
+    0x04, 0x09, 0x01, 0x12, 0x03, 0x4e, 0x08, 0x19, 0x0a, 0x0b, 0x0a, 0x04, 0x04, 0x09, 0x02, 0x00,
+    0x12, 0x03, 0x4f, 0x02, 0x15, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x09, 0x02, 0x00, 0x05, 0x12, 0x03,
+    0x4f, 0x02, 0x08, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x09, 0x02, 0x00, 0x01, 0x12, 0x03, 0x4f, 0x09,
+    0x10, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x09, 0x02, 0x00, 0x03, 0x12, 0x03, 0x4f, 0x13, 0x14, 0x0a,
+    0x0b, 0x0a, 0x04, 0x04, 0x09, 0x02, 0x01, 0x12, 0x03, 0x50, 0x02, 0x12, 0x0a, 0x0c, 0x0a, 0x05,
+    0x04, 0x09, 0x02, 0x01, 0x05, 0x12, 0x03, 0x50, 0x02, 0x08, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x09,
+    0x02, 0x01, 0x01, 0x12, 0x03, 0x50, 0x09, 0x0d, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x09, 0x02, 0x01,
+    0x03, 0x12, 0x03, 0x50, 0x10, 0x11, 0x0a, 0x0a, 0x0a, 0x02, 0x04, 0x0a, 0x12, 0x04, 0x53, 0x00,
+    0x55, 0x01, 0x0a, 0x0a, 0x0a, 0x03, 0x04, 0x0a, 0x01, 0x12, 0x03, 0x53, 0x08, 0x1a, 0x0a, 0x0b,
+    0x0a, 0x04, 0x04, 0x0a, 0x02, 0x00, 0x12, 0x03, 0x54, 0x02, 0x10, 0x0a, 0x0c, 0x0a, 0x05, 0x04,
+    0x0a, 0x02, 0x00, 0x06, 0x12, 0x03, 0x54, 0x02, 0x06, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x0a, 0x02,
+    0x00, 0x01, 0x12, 0x03, 0x54, 0x07, 0x0b, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x0a, 0x02, 0x00, 0x03,
+    0x12, 0x03, 0x54, 0x0e, 0x0f, 0x0a, 0x0a, 0x0a, 0x02, 0x04, 0x0b, 0x12, 0x04, 0x57, 0x00, 0x5b,
+    0x01, 0x0a, 0x0a, 0x0a, 0x03, 0x04, 0x0b, 0x01, 0x12, 0x03, 0x57, 0x08, 0x18, 0x0a, 0x0b, 0x0a,
+    0x04, 0x04, 0x0b, 0x02, 0x00, 0x12, 0x03, 0x58, 0x02, 0x18, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x0b,
+    0x02, 0x00, 0x06, 0x12, 0x03, 0x58, 0x02, 0x0c, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x0b, 0x02, 0x00,
+    0x01, 0x12, 0x03, 0x58, 0x0d, 0x13, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x0b, 0x02, 0x00, 0x03, 0x12,
+    0x03, 0x58, 0x16, 0x17, 0x0a, 0x0b, 0x0a, 0x04, 0x04, 0x0b, 0x02, 0x01, 0x12, 0x03, 0x59, 0x02,
+    0x16, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x0b, 0x02, 0x01, 0x05, 0x12, 0x03, 0x59, 0x02, 0x07, 0x0a,
+    0x0c, 0x0a, 0x05, 0x04, 0x0b, 0x02, 0x01, 0x01, 0x12, 0x03, 0x59, 0x08, 0x11, 0x0a, 0x0c, 0x0a,
+    0x05, 0x04, 0x0b, 0x02, 0x01, 0x03, 0x12, 0x03, 0x59, 0x14, 0x15, 0x0a, 0x0b, 0x0a, 0x04, 0x04,
+    0x0b, 0x02, 0x02, 0x12, 0x03, 0x5a, 0x02, 0x18, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x0b, 0x02, 0x02,
+    0x05, 0x12, 0x03, 0x5a, 0x02, 0x08, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x0b, 0x02, 0x02, 0x01, 0x12,
+    0x03, 0x5a, 0x09, 0x13, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x0b, 0x02, 0x02, 0x03, 0x12, 0x03, 0x5a,
+    0x16, 0x17, 0x0a, 0x0a, 0x0a, 0x02, 0x04, 0x0c, 0x12, 0x04, 0x5d, 0x00, 0x60, 0x01, 0x0a, 0x0a,
+    0x0a, 0x03, 0x04, 0x0c, 0x01, 0x12, 0x03, 0x5d, 0x08, 0x19, 0x0a, 0x0b, 0x0a, 0x04, 0x04, 0x0c,
+    0x02, 0x00, 0x12, 0x03, 0x5e, 0x02, 0x1a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x0c, 0x02, 0x00, 0x04,
+    0x12, 0x03, 0x5e, 0x02, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x0c, 0x02, 0x00, 0x06, 0x12, 0x03,
+    0x5e, 0x0b, 0x0f, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x0c, 0x02, 0x00, 0x01, 0x12, 0x03, 0x5e, 0x10,
+    0x15, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x0c, 0x02, 0x00, 0x03, 0x12, 0x03, 0x5e, 0x18, 0x19, 0x0a,
+    0x0b, 0x0a, 0x04, 0x04, 0x0c, 0x02, 0x01, 0x12, 0x03, 0x5f, 0x02, 0x1d, 0x0a, 0x0c, 0x0a, 0x05,
+    0x04, 0x0c, 0x02, 0x01, 0x05, 0x12, 0x03, 0x5f, 0x02, 0x08, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x0c,
+    0x02, 0x01, 0x01, 0x12, 0x03, 0x5f, 0x09, 0x18, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x0c, 0x02, 0x01,
+    0x03, 0x12, 0x03, 0x5f, 0x1b, 0x1c, 0x0a, 0x0a, 0x0a, 0x02, 0x04, 0x0d, 0x12, 0x04, 0x62, 0x00,
+    0x67, 0x01, 0x0a, 0x0a, 0x0a, 0x03, 0x04, 0x0d, 0x01, 0x12, 0x03, 0x62, 0x08, 0x14, 0x0a, 0x0a,
+    0x0a, 0x03, 0x04, 0x0d, 0x09, 0x12, 0x03, 0x63, 0x02, 0x0d, 0x0a, 0x0b, 0x0a, 0x04, 0x04, 0x0d,
+    0x09, 0x00, 0x12, 0x03, 0x63, 0x0b, 0x0c, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x0d, 0x09, 0x00, 0x01,
+    0x12, 0x03, 0x63, 0x0b, 0x0c, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x0d, 0x09, 0x00, 0x02, 0x12, 0x03,
+    0x63, 0x0b, 0x0c, 0x0a, 0x0b, 0x0a, 0x04, 0x04, 0x0d, 0x02, 0x00, 0x12, 0x03, 0x64, 0x02, 0x17,
+    0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x0d, 0x02, 0x00, 0x06, 0x12, 0x03, 0x64, 0x02, 0x0d, 0x0a, 0x0c,
+    0x0a, 0x05, 0x04, 0x0d, 0x02, 0x00, 0x01, 0x12, 0x03, 0x64, 0x0e, 0x12, 0x0a, 0x0c, 0x0a, 0x05,
+    0x04, 0x0d, 0x02, 0x00, 0x03, 0x12, 0x03, 0x64, 0x15, 0x16, 0x0a, 0x0b, 0x0a, 0x04, 0x04, 0x0d,
+    0x02, 0x01, 0x12, 0x03, 0x65, 0x02, 0x15, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x0d, 0x02, 0x01, 0x05,
+    0x12, 0x03, 0x65, 0x02, 0x08, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x0d, 0x02, 0x01, 0x01, 0x12, 0x03,
+    0x65, 0x09, 0x10, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x0d, 0x02, 0x01, 0x03, 0x12, 0x03, 0x65, 0x13,
+    0x14, 0x0a, 0x0b, 0x0a, 0x04, 0x04, 0x0d, 0x02, 0x02, 0x12, 0x03, 0x66, 0x02, 0x2f, 0x0a, 0x0c,
+    0x0a, 0x05, 0x04, 0x0d, 0x02, 0x02, 0x04, 0x12, 0x03, 0x66, 0x02, 0x0a, 0x0a, 0x0c, 0x0a, 0x05,
+    0x04, 0x0d, 0x02, 0x02, 0x06, 0x12, 0x03, 0x66, 0x0b, 0x19, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x0d,
+    0x02, 0x02, 0x01, 0x12, 0x03, 0x66, 0x1a, 0x2a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x0d, 0x02, 0x02,
+    0x03, 0x12, 0x03, 0x66, 0x2d, 0x2e, 0x0a, 0x0a, 0x0a, 0x02, 0x04, 0x0e, 0x12, 0x04, 0x69, 0x00,
+    0x6e, 0x01, 0x0a, 0x0a, 0x0a, 0x03, 0x04, 0x0e, 0x01, 0x12, 0x03, 0x69, 0x08, 0x16, 0x0a, 0x0a,
+    0x0a, 0x03, 0x04, 0x0e, 0x09, 0x12, 0x03, 0x6a, 0x02, 0x0d, 0x0a, 0x0b, 0x0a, 0x04, 0x04, 0x0e,
+    0x09, 0x00, 0x12, 0x03, 0x6a, 0x0b, 0x0c, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x0e, 0x09, 0x00, 0x01,
+    0x12, 0x03, 0x6a, 0x0b, 0x0c, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x0e, 0x09, 0x00, 0x02, 0x12, 0x03,
+    0x6a, 0x0b, 0x0c, 0x0a, 0x0b, 0x0a, 0x04, 0x04, 0x0e, 0x02, 0x00, 0x12, 0x03, 0x6b, 0x02, 0x13,
+    0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x0e, 0x02, 0x00, 0x05, 0x12, 0x03, 0x6b, 0x02, 0x08, 0x0a, 0x0c,
+    0x0a, 0x05, 0x04, 0x0e, 0x02, 0x00, 0x01, 0x12, 0x03, 0x6b, 0x09, 0x0e, 0x0a, 0x0c, 0x0a, 0x05,
+    0x04, 0x0e, 0x02, 0x00, 0x03, 0x12, 0x03, 0x6b, 0x11, 0x12, 0x0a, 0x0b, 0x0a, 0x04, 0x04, 0x0e,
+    0x02, 0x01, 0x12, 0x03, 0x6c, 0x02, 0x17, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x0e, 0x02, 0x01, 0x06,
+    0x12, 0x03, 0x6c, 0x02, 0x0d, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x0e, 0x02, 0x01, 0x01, 0x12, 0x03,
+    0x6c, 0x0e, 0x12, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x0e, 0x02, 0x01, 0x03, 0x12, 0x03, 0x6c, 0x15,
+    0x16, 0x0a, 0x0b, 0x0a, 0x04, 0x04, 0x0e, 0x02, 0x02, 0x12, 0x03, 0x6d, 0x02, 0x15, 0x0a, 0x0c,
+    0x0a, 0x05, 0x04, 0x0e, 0x02, 0x02, 0x05, 0x12, 0x03, 0x6d, 0x02, 0x08, 0x0a, 0x0c, 0x0a, 0x05,
+    0x04, 0x0e, 0x02, 0x02, 0x01, 0x12, 0x03, 0x6d, 0x09, 0x10, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x0e,
+    0x02, 0x02, 0x03, 0x12, 0x03, 0x6d, 0x13, 0x14, 0x0a, 0x0a, 0x0a, 0x02, 0x05, 0x00, 0x12, 0x04,
+    0x70, 0x00, 0x74, 0x01, 0x0a, 0x0a, 0x0a, 0x03, 0x05, 0x00, 0x01, 0x12, 0x03, 0x70, 0x05, 0x0f,
+    0x0a, 0x0b, 0x0a, 0x04, 0x05, 0x00, 0x02, 0x00, 0x12, 0x03, 0x71, 0x02, 0x1e, 0x0a, 0x0c, 0x0a,
+    0x05, 0x05, 0x00, 0x02, 0x00, 0x01, 0x12, 0x03, 0x71, 0x02, 0x19, 0x0a, 0x0c, 0x0a, 0x05, 0x05,
+    0x00, 0x02, 0x00, 0x02, 0x12, 0x03, 0x71, 0x1c, 0x1d, 0x0a, 0x0b, 0x0a, 0x04, 0x05, 0x00, 0x02,
+    0x01, 0x12, 0x03, 0x72, 0x02, 0x19, 0x0a, 0x0c, 0x0a, 0x05, 0x05, 0x00, 0x02, 0x01, 0x01, 0x12,
+    0x03, 0x72, 0x02, 0x14, 0x0a, 0x0c, 0x0a, 0x05, 0x05, 0x00, 0x02, 0x01, 0x02, 0x12, 0x03, 0x72,
+    0x17, 0x18, 0x0a, 0x0b, 0x0a, 0x04, 0x05, 0x00, 0x02, 0x02, 0x12, 0x03, 0x73, 0x02, 0x1a, 0x0a,
+    0x0c, 0x0a, 0x05, 0x05, 0x00, 0x02, 0x02, 0x01, 0x12, 0x03, 0x73, 0x02, 0x15, 0x0a, 0x0c, 0x0a,
+    0x05, 0x05, 0x00, 0x02, 0x02, 0x02, 0x12, 0x03, 0x73, 0x18, 0x19, 0x0a, 0x0b, 0x0a, 0x02, 0x05,
+    0x01, 0x12, 0x05, 0x76, 0x00, 0x96, 0x02, 0x01, 0x0a, 0x0a, 0x0a, 0x03, 0x05, 0x01, 0x01, 0x12,
+    0x03, 0x76, 0x05, 0x10, 0x0a, 0x0a, 0x0a, 0x03, 0x05, 0x01, 0x03, 0x12, 0x03, 0x77, 0x02, 0x38,
+    0x0a, 0x0d, 0x0a, 0x06, 0x05, 0x01, 0x03, 0xb4, 0x87, 0x03, 0x12, 0x03, 0x77, 0x02, 0x38, 0x0a,
+    0x0b, 0x0a, 0x04, 0x05, 0x01, 0x02, 0x00, 0x12, 0x03, 0x79, 0x02, 0x1f, 0x0a, 0x0c, 0x0a, 0x05,
+    0x05, 0x01, 0x02, 0x00, 0x01, 0x12, 0x03, 0x79, 0x02, 0x1a, 0x0a, 0x0c, 0x0a, 0x05, 0x05, 0x01,
+    0x02, 0x00, 0x02, 0x12, 0x03, 0x79, 0x1d, 0x1e, 0x0a, 0x0c, 0x0a, 0x04, 0x05, 0x01, 0x02, 0x01,
+    0x12, 0x04, 0x7a, 0x02, 0x7e, 0x05, 0x0a, 0x0c, 0x0a, 0x05, 0x05, 0x01, 0x02, 0x01, 0x01, 0x12,
+    0x03, 0x7a, 0x02, 0x13, 0x0a, 0x0c, 0x0a, 0x05, 0x05, 0x01, 0x02, 0x01, 0x02, 0x12, 0x03, 0x7a,
+    0x16, 0x17, 0x0a, 0x0d, 0x0a, 0x05, 0x05, 0x01, 0x02, 0x01, 0x03, 0x12, 0x04, 0x7a, 0x18, 0x7e,
+    0x04, 0x0a, 0x10, 0x0a, 0x08, 0x05, 0x01, 0x02, 0x01, 0x03, 0xb4, 0x87, 0x03, 0x12, 0x04, 0x7a,
+    0x19, 0x7e, 0x03, 0x0a, 0x10, 0x0a, 0x09, 0x05, 0x01, 0x02, 0x01, 0x03, 0xb4, 0x87, 0x03, 0x01,
+    0x12, 0x03, 0x7b, 0x04, 0x1f, 0x0a, 0x10, 0x0a, 0x09, 0x05, 0x01, 0x02, 0x01, 0x03, 0xb4, 0x87,
+    0x03, 0x02, 0x12, 0x03, 0x7c, 0x04, 0x20, 0x0a, 0x10, 0x0a, 0x09, 0x05, 0x01, 0x02, 0x01, 0x03,
+    0xb4, 0x87, 0x03, 0x05, 0x12, 0x03, 0x7d, 0x04, 0x13, 0x0a, 0x0d, 0x0a, 0x04, 0x05, 0x01, 0x02,
+    0x02, 0x12, 0x05, 0x7f, 0x02, 0x83, 0x01, 0x05, 0x0a, 0x0c, 0x0a, 0x05, 0x05, 0x01, 0x02, 0x02,
+    0x01, 0x12, 0x03, 0x7f, 0x02, 0x1b, 0x0a, 0x0c, 0x0a, 0x05, 0x05, 0x01, 0x02, 0x02, 0x02, 0x12,
+    0x03, 0x7f, 0x1e, 0x1f, 0x0a, 0x0e, 0x0a, 0x05, 0x05, 0x01, 0x02, 0x02, 0x03, 0x12, 0x05, 0x7f,
+    0x20, 0x83, 0x01, 0x04, 0x0a, 0x11, 0x0a, 0x08, 0x05, 0x01, 0x02, 0x02, 0x03, 0xb4, 0x87, 0x03,
+    0x12, 0x05, 0x7f, 0x21, 0x83, 0x01, 0x03, 0x0a, 0x11, 0x0a, 0x09, 0x05, 0x01, 0x02, 0x02, 0x03,
+    0xb4, 0x87, 0x03, 0x01, 0x12, 0x04, 0x80, 0x01, 0x04, 0x1f, 0x0a, 0x11, 0x0a, 0x09, 0x05, 0x01,
+    0x02, 0x02, 0x03, 0xb4, 0x87, 0x03, 0x02, 0x12, 0x04, 0x81, 0x01, 0x04, 0x27, 0x0a, 0x11, 0x0a,
+    0x09, 0x05, 0x01, 0x02, 0x02, 0x03, 0xb4, 0x87, 0x03, 0x03, 0x12, 0x04, 0x82, 0x01, 0x04, 0x15,
+    0x0a, 0x0e, 0x0a, 0x04, 0x05, 0x01, 0x02, 0x03, 0x12, 0x06, 0x84, 0x01, 0x02, 0x88, 0x01, 0x05,
+    0x0a, 0x0d, 0x0a, 0x05, 0x05, 0x01, 0x02, 0x03, 0x01, 0x12, 0x04, 0x84, 0x01, 0x02, 0x1e, 0x0a,
+    0x0d, 0x0a, 0x05, 0x05, 0x01, 0x02, 0x03, 0x02, 0x12, 0x04, 0x84, 0x01, 0x21, 0x22, 0x0a, 0x0f,
+    0x0a, 0x05, 0x05, 0x01, 0x02, 0x03, 0x03, 0x12, 0x06, 0x84, 0x01, 0x23, 0x88, 0x01, 0x04, 0x0a,
+    0x12, 0x0a, 0x08, 0x05, 0x01, 0x02, 0x03, 0x03, 0xb4, 0x87, 0x03, 0x12, 0x06, 0x84, 0x01, 0x24,
+    0x88, 0x01, 0x03, 0x0a, 0x11, 0x0a, 0x09, 0x05, 0x01, 0x02, 0x03, 0x03, 0xb4, 0x87, 0x03, 0x01,
+    0x12, 0x04, 0x85, 0x01, 0x04, 0x1f, 0x0a, 0x11, 0x0a, 0x09, 0x05, 0x01, 0x02, 0x03, 0x03, 0xb4,
+    0x87, 0x03, 0x02, 0x12, 0x04, 0x86, 0x01, 0x04, 0x2e, 0x0a, 0x11, 0x0a, 0x09, 0x05, 0x01, 0x02,
+    0x03, 0x03, 0xb4, 0x87, 0x03, 0x03, 0x12, 0x04, 0x87, 0x01, 0x04, 0x15, 0x0a, 0x0e, 0x0a, 0x04,
+    0x05, 0x01, 0x02, 0x04, 0x12, 0x06, 0x89, 0x01, 0x02, 0x8d, 0x01, 0x05, 0x0a, 0x0d, 0x0a, 0x05,
+    0x05, 0x01, 0x02, 0x04, 0x01, 0x12, 0x04, 0x89, 0x01, 0x02, 0x1f, 0x0a, 0x0d, 0x0a, 0x05, 0x05,
+    0x01, 0x02, 0x04, 0x02, 0x12, 0x04, 0x89, 0x01, 0x22, 0x24, 0x0a, 0x0f, 0x0a, 0x05, 0x05, 0x01,
+    0x02, 0x04, 0x03, 0x12, 0x06, 0x89, 0x01, 0x25, 0x8d, 0x01, 0x04, 0x0a, 0x12, 0x0a, 0x08, 0x05,
+    0x01, 0x02, 0x04, 0x03, 0xb4, 0x87, 0x03, 0x12, 0x06, 0x89, 0x01, 0x26, 0x8d, 0x01, 0x03, 0x0a,
+    0x11, 0x0a, 0x09, 0x05, 0x01, 0x02, 0x04, 0x03, 0xb4, 0x87, 0x03, 0x01, 0x12, 0x04, 0x8a, 0x01,
+    0x04, 0x1f, 0x0a, 0x11, 0x0a, 0x09, 0x05, 0x01, 0x02, 0x04, 0x03, 0xb4, 0x87, 0x03, 0x02, 0x12,
+    0x04, 0x8b, 0x01, 0x04, 0x2f, 0x0a, 0x11, 0x0a, 0x09, 0x05, 0x01, 0x02, 0x04, 0x03, 0xb4, 0x87,
+    0x03, 0x03, 0x12, 0x04, 0x8c, 0x01, 0x04, 0x15, 0x0a, 0x0e, 0x0a, 0x04, 0x05, 0x01, 0x02, 0x05,
+    0x12, 0x06, 0x8e, 0x01, 0x02, 0x92, 0x01, 0x05, 0x0a, 0x0d, 0x0a, 0x05, 0x05, 0x01, 0x02, 0x05,
+    0x01, 0x12, 0x04, 0x8e, 0x01, 0x02, 0x1d, 0x0a, 0x0d, 0x0a, 0x05, 0x05, 0x01, 0x02, 0x05, 0x02,
+    0x12, 0x04, 0x8e, 0x01, 0x20, 0x22, 0x0a, 0x0f, 0x0a, 0x05, 0x05, 0x01, 0x02, 0x05, 0x03, 0x12,
+    0x06, 0x8e, 0x01, 0x23, 0x92, 0x01, 0x04, 0x0a, 0x12, 0x0a, 0x08, 0x05, 0x01, 0x02, 0x05, 0x03,
+    0xb4, 0x87, 0x03, 0x12, 0x06, 0x8e, 0x01, 0x24, 0x92, 0x01, 0x03, 0x0a, 0x11, 0x0a, 0x09, 0x05,
+    0x01, 0x02, 0x05, 0x03, 0xb4, 0x87, 0x03, 0x01, 0x12, 0x04, 0x8f, 0x01, 0x04, 0x1f, 0x0a, 0x11,
+    0x0a, 0x09, 0x05, 0x01, 0x02, 0x05, 0x03, 0xb4, 0x87, 0x03, 0x02, 0x12, 0x04, 0x90, 0x01, 0x04,
+    0x33, 0x0a, 0x11, 0x0a, 0x09, 0x05, 0x01, 0x02, 0x05, 0x03, 0xb4, 0x87, 0x03, 0x03, 0x12, 0x04,
+    0x91, 0x01, 0x04, 0x15, 0x0a, 0x0e, 0x0a, 0x04, 0x05, 0x01, 0x02, 0x06, 0x12, 0x06, 0x93, 0x01,
+    0x02, 0x97, 0x01, 0x05, 0x0a, 0x0d, 0x0a, 0x05, 0x05, 0x01, 0x02, 0x06, 0x01, 0x12, 0x04, 0x93,
+    0x01, 0x02, 0x1d, 0x0a, 0x0d, 0x0a, 0x05, 0x05, 0x01, 0x02, 0x06, 0x02, 0x12, 0x04, 0x93, 0x01,
+    0x20, 0x22, 0x0a, 0x0f, 0x0a, 0x05, 0x05, 0x01, 0x02, 0x06, 0x03, 0x12, 0x06, 0x93, 0x01, 0x23,
+    0x97, 0x01, 0x04, 0x0a, 0x12, 0x0a, 0x08, 0x05, 0x01, 0x02, 0x06, 0x03, 0xb4, 0x87, 0x03, 0x12,
+    0x06, 0x93, 0x01, 0x24, 0x97, 0x01, 0x03, 0x0a, 0x11, 0x0a, 0x09, 0x05, 0x01, 0x02, 0x06, 0x03,
+    0xb4, 0x87, 0x03, 0x01, 0x12, 0x04, 0x94, 0x01, 0x04, 0x1f, 0x0a, 0x11, 0x0a, 0x09, 0x05, 0x01,
+    0x02, 0x06, 0x03, 0xb4, 0x87, 0x03, 0x02, 0x12, 0x04, 0x95, 0x01, 0x04, 0x26, 0x0a, 0x11, 0x0a,
+    0x09, 0x05, 0x01, 0x02, 0x06, 0x03, 0xb4, 0x87, 0x03, 0x03, 0x12, 0x04, 0x96, 0x01, 0x04, 0x15,
+    0x0a, 0x0e, 0x0a, 0x04, 0x05, 0x01, 0x02, 0x07, 0x12, 0x06, 0x98, 0x01, 0x02, 0x9c, 0x01, 0x05,
+    0x0a, 0x0d, 0x0a, 0x05, 0x05, 0x01, 0x02, 0x07, 0x01, 0x12, 0x04, 0x98, 0x01, 0x02, 0x18, 0x0a,
+    0x0d, 0x0a, 0x05, 0x05, 0x01, 0x02, 0x07, 0x02, 0x12, 0x04, 0x98, 0x01, 0x1b, 0x1c, 0x0a, 0x0f,
+    0x0a, 0x05, 0x05, 0x01, 0x02, 0x07, 0x03, 0x12, 0x06, 0x98, 0x01, 0x1d, 0x9c, 0x01, 0x04, 0x0a,
+    0x12, 0x0a, 0x08, 0x05, 0x01, 0x02, 0x07, 0x03, 0xb4, 0x87, 0x03, 0x12, 0x06, 0x98, 0x01, 0x1e,
+    0x9c, 0x01, 0x03, 0x0a, 0x11, 0x0a, 0x09, 0x05, 0x01, 0x02, 0x07, 0x03, 0xb4, 0x87, 0x03, 0x01,
+    0x12, 0x04, 0x99, 0x01, 0x04, 0x1f, 0x0a, 0x11, 0x0a, 0x09, 0x05, 0x01, 0x02, 0x07, 0x03, 0xb4,
+    0x87, 0x03, 0x02, 0x12, 0x04, 0x9a, 0x01, 0x04, 0x24, 0x0a, 0x11, 0x0a, 0x09, 0x05, 0x01, 0x02,
+    0x07, 0x03, 0xb4, 0x87, 0x03, 0x03, 0x12, 0x04, 0x9b, 0x01, 0x04, 0x12, 0x0a, 0x0e, 0x0a, 0x04,
+    0x05, 0x01, 0x02, 0x08, 0x12, 0x06, 0x9d, 0x01, 0x02, 0xa1, 0x01, 0x05, 0x0a, 0x0d, 0x0a, 0x05,
+    0x05, 0x01, 0x02, 0x08, 0x01, 0x12, 0x04, 0x9d, 0x01, 0x02, 0x1a, 0x0a, 0x0d, 0x0a, 0x05, 0x05,
+    0x01, 0x02, 0x08, 0x02, 0x12, 0x04, 0x9d, 0x01, 0x1d, 0x1e, 0x0a, 0x0f, 0x0a, 0x05, 0x05, 0x01,
+    0x02, 0x08, 0x03, 0x12, 0x06, 0x9d, 0x01, 0x1f, 0xa1, 0x01, 0x04, 0x0a, 0x12, 0x0a, 0x08, 0x05,
+    0x01, 0x02, 0x08, 0x03, 0xb4, 0x87, 0x03, 0x12, 0x06, 0x9d, 0x01, 0x20, 0xa1, 0x01, 0x03, 0x0a,
+    0x11, 0x0a, 0x09, 0x05, 0x01, 0x02, 0x08, 0x03, 0xb4, 0x87, 0x03, 0x01, 0x12, 0x04, 0x9e, 0x01,
+    0x04, 0x1f, 0x0a, 0x11, 0x0a, 0x09, 0x05, 0x01, 0x02, 0x08, 0x03, 0xb4, 0x87, 0x03, 0x02, 0x12,
+    0x04, 0x9f, 0x01, 0x04, 0x23, 0x0a, 0x11, 0x0a, 0x09, 0x05, 0x01, 0x02, 0x08, 0x03, 0xb4, 0x87,
+    0x03, 0x03, 0x12, 0x04, 0xa0, 0x01, 0x04, 0x12, 0x0a, 0x0e, 0x0a, 0x04, 0x05, 0x01, 0x02, 0x09,
+    0x12, 0x06, 0xa2, 0x01, 0x02, 0xa6, 0x01, 0x05, 0x0a, 0x0d, 0x0a, 0x05, 0x05, 0x01, 0x02, 0x09,
+    0x01, 0x12, 0x04, 0xa2, 0x01, 0x02, 0x25, 0x0a, 0x0d, 0x0a, 0x05, 0x05, 0x01, 0x02, 0x09, 0x02,
+    0x12, 0x04, 0xa2, 0x01, 0x28, 0x2a, 0x0a, 0x0f, 0x0a, 0x05, 0x05, 0x01, 0x02, 0x09, 0x03, 0x12,
+    0x06, 0xa2, 0x01, 0x2b, 0xa6, 0x01, 0x04, 0x0a, 0x12, 0x0a, 0x08, 0x05, 0x01, 0x02, 0x09, 0x03,
+    0xb4, 0x87, 0x03, 0x12, 0x06, 0xa2, 0x01, 0x2c, 0xa6, 0x01, 0x03, 0x0a, 0x11, 0x0a, 0x09, 0x05,
+    0x01, 0x02, 0x09, 0x03, 0xb4, 0x87, 0x03, 0x01, 0x12, 0x04, 0xa3, 0x01, 0x04, 0x1f, 0x0a, 0x11,
+    0x0a, 0x09, 0x05, 0x01, 0x02, 0x09, 0x03, 0xb4, 0x87, 0x03, 0x02, 0x12, 0x04, 0xa4, 0x01, 0x04,
+    0x2a, 0x0a, 0x11, 0x0a, 0x09, 0x05, 0x01, 0x02, 0x09, 0x03, 0xb4, 0x87, 0x03, 0x03, 0x12, 0x04,
+    0xa5, 0x01, 0x04, 0x12, 0x0a, 0x0e, 0x0a, 0x04, 0x05, 0x01, 0x02, 0x0a, 0x12, 0x06, 0xa7, 0x01,
+    0x02, 0xab, 0x01, 0x05, 0x0a, 0x0d, 0x0a, 0x05, 0x05, 0x01, 0x02, 0x0a, 0x01, 0x12, 0x04, 0xa7,
+    0x01, 0x02, 0x1b, 0x0a, 0x0d, 0x0a, 0x05, 0x05, 0x01, 0x02, 0x0a, 0x02, 0x12, 0x04, 0xa7, 0x01,
+    0x1e, 0x20, 0x0a, 0x0f, 0x0a, 0x05, 0x05, 0x01, 0x02, 0x0a, 0x03, 0x12, 0x06, 0xa7, 0x01, 0x21,
+    0xab, 0x01, 0x04, 0x0a, 0x12, 0x0a, 0x08, 0x05, 0x01, 0x02, 0x0a, 0x03, 0xb4, 0x87, 0x03, 0x12,
+    0x06, 0xa7, 0x01, 0x22, 0xab, 0x01, 0x03, 0x0a, 0x11, 0x0a, 0x09, 0x05, 0x01, 0x02, 0x0a, 0x03,
+    0xb4, 0x87, 0x03, 0x01, 0x12, 0x04, 0xa8, 0x01, 0x04, 0x1f, 0x0a, 0x11, 0x0a, 0x09, 0x05, 0x01,
+    0x02, 0x0a, 0x03, 0xb4, 0x87, 0x03, 0x02, 0x12, 0x04, 0xa9, 0x01, 0x04, 0x2b, 0x0a, 0x11, 0x0a,
+    0x09, 0x05, 0x01, 0x02, 0x0a, 0x03, 0xb4, 0x87, 0x03, 0x03, 0x12, 0x04, 0xaa, 0x01, 0x04, 0x12,
+    0x0a, 0x72, 0x0a, 0x04, 0x05, 0x01, 0x02, 0x0b, 0x12, 0x06, 0xae, 0x01, 0x02, 0xb2, 0x01, 0x05,
+    0x1a, 0x62, 0x20, 0x41, 0x20, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x20, 0x63, 0x6f, 0x6e, 0x73, 0x74,
+    0x72, 0x61, 0x69, 0x6e, 0x74, 0x20, 0x64, 0x65, 0x63, 0x6c, 0x61, 0x72, 0x65, 0x64, 0x20, 0x69,
+    0x6e, 0x20, 0x74, 0x68, 0x65, 0x20, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x20, 0x74, 0x68, 0x61, 0x74,
+    0x20, 0x6e, 0x6f, 0x20, 0x6f, 0x74, 0x68, 0x65, 0x72, 0x20, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e,
+    0x20, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x73, 0x2c, 0x0a, 0x20, 0x65, 0x2e, 0x67,
+    0x2e, 0x20, 0x22, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x70, 0x61, 0x74, 0x74, 0x65, 0x72,
+    0x6e, 0x22, 0x2e, 0x0a, 0x0a, 0x0d, 0x0a, 0x05, 0x05, 0x01, 0x02, 0x0b, 0x01, 0x12, 0x04, 0xae,
+    0x01, 0x02, 0x20, 0x0a, 0x0d, 0x0a, 0x05, 0x05, 0x01, 0x02, 0x0b, 0x02, 0x12, 0x04, 0xae, 0x01,
+    0x23, 0x25, 0x0a, 0x0f, 0x0a, 0x05, 0x05, 0x01, 0x02, 0x0b, 0x03, 0x12, 0x06, 0xae, 0x01, 0x26,
+    0xb2, 0x01, 0x04, 0x0a, 0x12, 0x0a, 0x08, 0x05, 0x01, 0x02, 0x0b, 0x03, 0xb4, 0x87, 0x03, 0x12,
+    0x06, 0xae, 0x01, 0x27, 0xb2, 0x01, 0x03, 0x0a, 0x11, 0x0a, 0x09, 0x05, 0x01, 0x02, 0x0b, 0x03,
+    0xb4, 0x87, 0x03, 0x01, 0x12, 0x04, 0xaf, 0x01, 0x04, 0x1f, 0x0a, 0x11, 0x0a, 0x09, 0x05, 0x01,
+    0x02, 0x0b, 0x03, 0xb4, 0x87, 0x03, 0x02, 0x12, 0x04, 0xb0, 0x01, 0x04, 0x35, 0x0a, 0x12, 0x0a,
+    0x0a, 0x05, 0x01, 0x02, 0x0b, 0x03, 0xb4, 0x87, 0x03, 0x04, 0x00, 0x12, 0x04, 0xb1, 0x01, 0x0f,
+    0x1b, 0x0a, 0x0e, 0x0a, 0x04, 0x05, 0x01, 0x02, 0x0c, 0x12, 0x06, 0xb3, 0x01, 0x02, 0xb8, 0x01,
+    0x05, 0x0a, 0x0d, 0x0a, 0x05, 0x05, 0x01, 0x02, 0x0c, 0x01, 0x12, 0x04, 0xb3, 0x01, 0x02, 0x11,
+    0x0a, 0x0d, 0x0a, 0x05, 0x05, 0x01, 0x02, 0x0c, 0x02, 0x12, 0x04, 0xb3, 0x01, 0x14, 0x15, 0x0a,
+    0x0f, 0x0a, 0x05, 0x05, 0x01, 0x02, 0x0c, 0x03, 0x12, 0x06, 0xb3, 0x01, 0x16, 0xb8, 0x01, 0x04,
+    0x0a, 0x12, 0x0a, 0x08, 0x05, 0x01, 0x02, 0x0c, 0x03, 0xb4, 0x87, 0x03, 0x12, 0x06, 0xb3, 0x01,
+    0x17, 0xb8, 0x01, 0x03, 0x0a, 0x11, 0x0a, 0x09, 0x05, 0x01, 0x02, 0x0c, 0x03, 0xb4, 0x87, 0x03,
+    0x01, 0x12, 0x04, 0xb4, 0x01, 0x04, 0x1d, 0x0a, 0x11, 0x0a, 0x09, 0x05, 0x01, 0x02, 0x0c, 0x03,
+    0xb4, 0x87, 0x03, 0x02, 0x12, 0x04, 0xb5, 0x01, 0x04, 0x23, 0x0a, 0x11, 0x0a, 0x09, 0x05, 0x01,
+    0x02, 0x0c, 0x03, 0xb4, 0x87, 0x03, 0x03, 0x12, 0x04, 0xb6, 0x01, 0x04, 0x12, 0x0a, 0x12, 0x0a,
+    0x0a, 0x05, 0x01, 0x02, 0x0c, 0x03, 0xb4, 0x87, 0x03, 0x04, 0x00, 0x12, 0x04, 0xb7, 0x01, 0x0f,
+    0x16, 0x0a, 0x0e, 0x0a, 0x04, 0x05, 0x01, 0x02, 0x0d, 0x12, 0x06, 0xb9, 0x01, 0x02, 0xbe, 0x01,
+    0x05, 0x0a, 0x0d, 0x0a, 0x05, 0x05, 0x01, 0x02, 0x0d, 0x01, 0x12, 0x04, 0xb9, 0x01, 0x02, 0x14,
+    0x0a, 0x0d, 0x0a, 0x05, 0x05, 0x01, 0x02, 0x0d, 0x02, 0x12, 0x04, 0xb9, 0x01, 0x17, 0x18, 0x0a,
+    0x0f, 0x0a, 0x05, 0x05, 0x01, 0x02, 0x0d, 0x03, 0x12, 0x06, 0xb9, 0x01, 0x19, 0xbe, 0x01, 0x04,
+    0x0a, 0x12, 0x0a, 0x08, 0x05, 0x01, 0x02, 0x0d, 0x03, 0xb4, 0x87, 0x03, 0x12, 0x06, 0xb9, 0x01,
+    0x1a, 0xbe, 0x01, 0x03, 0x0a, 0x11, 0x0a, 0x09, 0x05, 0x01, 0x02, 0x0d, 0x03, 0xb4, 0x87, 0x03,
+    0x01, 0x12, 0x04, 0xba, 0x01, 0x04, 0x1d, 0x0a, 0x11, 0x0a, 0x09, 0x05, 0x01, 0x02, 0x0d, 0x03,
+    0xb4, 0x87, 0x03, 0x02, 0x12, 0x04, 0xbb, 0x01, 0x04, 0x26, 0x0a, 0x11, 0x0a, 0x09, 0x05, 0x01,
+    0x02, 0x0d, 0x03, 0xb4, 0x87, 0x03, 0x03, 0x12, 0x04, 0xbc, 0x01, 0x04, 0x15, 0x0a, 0x12, 0x0a,
+    0x0a, 0x05, 0x01, 0x02, 0x0d, 0x03, 0xb4, 0x87, 0x03, 0x04, 0x00, 0x12, 0x04, 0xbd, 0x01, 0x0f,
+    0x19, 0x0a, 0x0e, 0x0a, 0x04, 0x05, 0x01, 0x02, 0x0e, 0x12, 0x06, 0xbf, 0x01, 0x02, 0xc2, 0x01,
+    0x05, 0x0a, 0x0d, 0x0a, 0x05, 0x05, 0x01, 0x02, 0x0e, 0x01, 0x12, 0x04, 0xbf, 0x01, 0x02, 0x10,
+    0x0a, 0x0d, 0x0a, 0x05, 0x05, 0x01, 0x02, 0x0e, 0x02, 0x12, 0x04, 0xbf, 0x01, 0x13, 0x14, 0x0a,
+    0x0f, 0x0a, 0x05, 0x05, 0x01, 0x02, 0x0e, 0x03, 0x12, 0x06, 0xbf, 0x01, 0x15, 0xc2, 0x01, 0x04,
+    0x0a, 0x12, 0x0a, 0x08, 0x05, 0x01, 0x02, 0x0e, 0x03, 0xb4, 0x87, 0x03, 0x12, 0x06, 0xbf, 0x01,
+    0x16, 0xc2, 0x01, 0x03, 0x0a, 0x11, 0x0a, 0x09, 0x05, 0x01, 0x02, 0x0e, 0x03, 0xb4, 0x87, 0x03,
+    0x01, 0x12, 0x04, 0xc0, 0x01, 0x04, 0x18, 0x0a, 0x11, 0x0a, 0x09, 0x05, 0x01, 0x02, 0x0e, 0x03,
+    0xb4, 0x87, 0x03, 0x02, 0x12, 0x04, 0xc1, 0x01, 0x04, 0x1d, 0x0a, 0x0e, 0x0a, 0x04, 0x05, 0x01,
+    0x02, 0x0f, 0x12, 0x06, 0xc3, 0x01, 0x02, 0xc8, 0x01, 0x05, 0x0a, 0x0d, 0x0a, 0x05, 0x05, 0x01,
+    0x02, 0x0f, 0x01, 0x12, 0x04, 0xc3, 0x01, 0x02, 0x13, 0x0a, 0x0d, 0x0a, 0x05, 0x05, 0x01, 0x02,
+    0x0f, 0x02, 0x12, 0x04, 0xc3, 0x01, 0x16, 0x17, 0x0a, 0x0f, 0x0a, 0x05, 0x05, 0x01, 0x02, 0x0f,
+    0x03, 0x12, 0x06, 0xc3, 0x01, 0x18, 0xc8, 0x01, 0x04, 0x0a, 0x12, 0x0a, 0x08, 0x05, 0x01, 0x02,
+    0x0f, 0x03, 0xb4, 0x87, 0x03, 0x12, 0x06, 0xc3, 0x01, 0x19, 0xc8, 0x01, 0x03, 0x0a, 0x11, 0x0a,
+    0x09, 0x05, 0x01, 0x02, 0x0f, 0x03, 0xb4, 0x87, 0x03, 0x01, 0x12, 0x04, 0xc4, 0x01, 0x04, 0x1f,
+    0x0a, 0x11, 0x0a, 0x09, 0x05, 0x01, 0x02, 0x0f, 0x03, 0xb4, 0x87, 0x03, 0x02, 0x12, 0x04, 0xc5,
+    0x01, 0x04, 0x42, 0x0a, 0x11, 0x0a, 0x09, 0x05, 0x01, 0x02, 0x0f, 0x03, 0xb4, 0x87, 0x03, 0x03,
+    0x12, 0x04, 0xc6, 0x01, 0x04, 0x17, 0x0a, 0x12, 0x0a, 0x0a, 0x05, 0x01, 0x02, 0x0f, 0x03, 0xb4,
+    0x87, 0x03, 0x04, 0x00, 0x12, 0x04, 0xc7, 0x01, 0x0f, 0x1b, 0x0a, 0x0e, 0x0a, 0x04, 0x05, 0x01,
+    0x02, 0x10, 0x12, 0x06, 0xc9, 0x01, 0x02, 0xce, 0x01, 0x05, 0x0a, 0x0d, 0x0a, 0x05, 0x05, 0x01,
+    0x02, 0x10, 0x01, 0x12, 0x04, 0xc9, 0x01, 0x02, 0x11, 0x0a, 0x0d, 0x0a, 0x05, 0x05, 0x01, 0x02,
+    0x10, 0x02, 0x12, 0x04, 0xc9, 0x01, 0x14, 0x16, 0x0a, 0x0f, 0x0a, 0x05, 0x05, 0x01, 0x02, 0x10,
+    0x03, 0x12, 0x06, 0xc9, 0x01, 0x17, 0xce, 0x01, 0x04, 0x0a, 0x12, 0x0a, 0x08, 0x05, 0x01, 0x02,
+    0x10, 0x03, 0xb4, 0x87, 0x03, 0x12, 0x06, 0xc9, 0x01, 0x18, 0xce, 0x01, 0x03, 0x0a, 0x11, 0x0a,
+    0x09, 0x05, 0x01, 0x02, 0x10, 0x03, 0xb4, 0x87, 0x03, 0x01, 0x12, 0x04, 0xca, 0x01, 0x04, 0x1f,
+    0x0a, 0x11, 0x0a, 0x09, 0x05, 0x01, 0x02, 0x10, 0x03, 0xb4, 0x87, 0x03, 0x02, 0x12, 0x04, 0xcb,
+    0x01, 0x04, 0x25, 0x0a, 0x11, 0x0a, 0x09, 0x05, 0x01, 0x02, 0x10, 0x03, 0xb4, 0x87, 0x03, 0x03,
+    0x12, 0x04, 0xcc, 0x01, 0x04, 0x14, 0x0a, 0x12, 0x0a, 0x0a, 0x05, 0x01, 0x02, 0x10, 0x03, 0xb4,
+    0x87, 0x03, 0x04, 0x00, 0x12, 0x04, 0xcd, 0x01, 0x0f, 0x18, 0x0a, 0x0e, 0x0a, 0x04, 0x05, 0x01,
+    0x02, 0x11, 0x12, 0x06, 0xcf, 0x01, 0x02, 0xd4, 0x01, 0x05, 0x0a, 0x0d, 0x0a, 0x05, 0x05, 0x01,
+    0x02, 0x11, 0x01, 0x12, 0x04, 0xcf, 0x01, 0x02, 0x15, 0x0a, 0x0d, 0x0a, 0x05, 0x05, 0x01, 0x02,
+    0x11, 0x02, 0x12, 0x04, 0xcf, 0x01, 0x18, 0x1a, 0x0a, 0x0f, 0x0a, 0x05, 0x05, 0x01, 0x02, 0x11,
+    0x03, 0x12, 0x06, 0xcf, 0x01, 0x1b, 0xd4, 0x01, 0x04, 0x0a, 0x12, 0x0a, 0x08, 0x05, 0x01, 0x02,
+    0x11, 0x03, 0xb4, 0x87, 0x03, 0x12, 0x06, 0xcf, 0x01, 0x1c, 0xd4, 0x01, 0x03, 0x0a, 0x11, 0x0a,
+    0x09, 0x05, 0x01, 0x02, 0x11, 0x03, 0xb4, 0x87, 0x03, 0x01, 0x12, 0x04, 0xd0, 0x01, 0x04, 0x1f,
+    0x0a, 0x11, 0x0a, 0x09, 0x05, 0x01, 0x02, 0x11, 0x03, 0xb4, 0x87, 0x03, 0x02, 0x12, 0x04, 0xd1,
+    0x01, 0x04, 0x22, 0x0a, 0x11, 0x0a, 0x09, 0x05, 0x01, 0x02, 0x11, 0x03, 0xb4, 0x87, 0x03, 0x03,
+    0x12, 0x04, 0xd2, 0x01, 0x04, 0x13, 0x0a, 0x12, 0x0a, 0x0a, 0x05, 0x01, 0x02, 0x11, 0x03, 0xb4,
+    0x87, 0x03, 0x04, 0x00, 0x12, 0x04, 0xd3, 0x01, 0x0f, 0x17, 0x0a, 0x0e, 0x0a, 0x04, 0x05, 0x01,
+    0x02, 0x12, 0x12, 0x06, 0xd5, 0x01, 0x02, 0xd9, 0x01, 0x05, 0x0a, 0x0d, 0x0a, 0x05, 0x05, 0x01,
+    0x02, 0x12, 0x01, 0x12, 0x04, 0xd5, 0x01, 0x02, 0x13, 0x0a, 0x0d, 0x0a, 0x05, 0x05, 0x01, 0x02,
+    0x12, 0x02, 0x12, 0x04, 0xd5, 0x01, 0x16, 0x18, 0x0a, 0x0f, 0x0a, 0x05, 0x05, 0x01, 0x02, 0x12,
+    0x03, 0x12, 0x06, 0xd5, 0x01, 0x19, 0xd9, 0x01, 0x04, 0x0a, 0x12, 0x0a, 0x08, 0x05, 0x01, 0x02,
+    0x12, 0x03, 0xb4, 0x87, 0x03, 0x12, 0x06, 0xd5, 0x01, 0x1a, 0xd9, 0x01, 0x03, 0x0a, 0x11, 0x0a,
+    0x09, 0x05, 0x01, 0x02, 0x12, 0x03, 0xb4, 0x87, 0x03, 0x01, 0x12, 0x04, 0xd6, 0x01, 0x04, 0x1f,
+    0x0a, 0x11, 0x0a, 0x09, 0x05, 0x01, 0x02, 0x12, 0x03, 0xb4, 0x87, 0x03, 0x02, 0x12, 0x04, 0xd7,
+    0x01, 0x04, 0x39, 0x0a, 0x11, 0x0a, 0x09, 0x05, 0x01, 0x02, 0x12, 0x03, 0xb4, 0x87, 0x03, 0x03,
+    0x12, 0x04, 0xd8, 0x01, 0x04, 0x18, 0x0a, 0x0e, 0x0a, 0x04, 0x05, 0x01, 0x02, 0x13, 0x12, 0x06,
+    0xda, 0x01, 0x02, 0xdf, 0x01, 0x05, 0x0a, 0x0d, 0x0a, 0x05, 0x05, 0x01, 0x02, 0x13, 0x01, 0x12,
+    0x04, 0xda, 0x01, 0x02, 0x1a, 0x0a, 0x0d, 0x0a, 0x05, 0x05, 0x01, 0x02, 0x13, 0x02, 0x12, 0x04,
+    0xda, 0x01, 0x1d, 0x1f, 0x0a, 0x0f, 0x0a, 0x05, 0x05, 0x01, 0x02, 0x13, 0x03, 0x12, 0x06, 0xda,
+    0x01, 0x20, 0xdf, 0x01, 0x04, 0x0a, 0x12, 0x0a, 0x08, 0x05, 0x01, 0x02, 0x13, 0x03, 0xb4, 0x87,
+    0x03, 0x12, 0x06, 0xda, 0x01, 0x21, 0xdf, 0x01, 0x03, 0x0a, 0x11, 0x0a, 0x09, 0x05, 0x01, 0x02,
+    0x13, 0x03, 0xb4, 0x87, 0x03, 0x01, 0x12, 0x04, 0xdb, 0x01, 0x04, 0x1f, 0x0a, 0x11, 0x0a, 0x09,
+    0x05, 0x01, 0x02, 0x13, 0x03, 0xb4, 0x87, 0x03, 0x02, 0x12, 0x04, 0xdc, 0x01, 0x04, 0x3f, 0x0a,
+    0x11, 0x0a, 0x09, 0x05, 0x01, 0x02, 0x13, 0x03, 0xb4, 0x87, 0x03, 0x03, 0x12, 0x04, 0xdd, 0x01,
+    0x04, 0x18, 0x0a, 0x12, 0x0a, 0x0a, 0x05, 0x01, 0x02, 0x13, 0x03, 0xb4, 0x87, 0x03, 0x04, 0x00,
+    0x12, 0x04, 0xde, 0x01, 0x0f, 0x15, 0x0a, 0x0e, 0x0a, 0x04, 0x05, 0x01, 0x02, 0x14, 0x12, 0x06,
+    0xe0, 0x01, 0x02, 0xe5, 0x01, 0x05, 0x0a, 0x0d, 0x0a, 0x05, 0x05, 0x01, 0x02, 0x14, 0x01, 0x12,
+    0x04, 0xe0, 0x01, 0x02, 0x13, 0x0a, 0x0d, 0x0a, 0x05, 0x05, 0x01, 0x02, 0x14, 0x02, 0x12, 0x04,
+    0xe0, 0x01, 0x16, 0x18, 0x0a, 0x0f, 0x0a, 0x05, 0x05, 0x01, 0x02, 0x14, 0x03, 0x12, 0x06, 0xe0,
+    0x01, 0x19, 0xe5, 0x01, 0x04, 0x0a, 0x12, 0x0a, 0x08, 0x05, 0x01, 0x02, 0x14, 0x03, 0xb4, 0x87,
+    0x03, 0x12, 0x06, 0xe0, 0x01, 0x1a, 0xe5, 0x01, 0x03, 0x0a, 0x11, 0x0a, 0x09, 0x05, 0x01, 0x02,
+    0x14, 0x03, 0xb4, 0x87, 0x03, 0x01, 0x12, 0x04, 0xe1, 0x01, 0x04, 0x1f, 0x0a, 0x11, 0x0a, 0x09,
+    0x05, 0x01, 0x02, 0x14, 0x03, 0xb4, 0x87, 0x03, 0x02, 0x12, 0x04, 0xe2, 0x01, 0x04, 0x2d, 0x0a,
+    0x11, 0x0a, 0x09, 0x05, 0x01, 0x02, 0x14, 0x03, 0xb4, 0x87, 0x03, 0x03, 0x12, 0x04, 0xe3, 0x01,
+    0x04, 0x16, 0x0a, 0x12, 0x0a, 0x0a, 0x05, 0x01, 0x02, 0x14, 0x03, 0xb4, 0x87, 0x03, 0x04, 0x00,
+    0x12, 0x04, 0xe4, 0x01, 0x0f, 0x1a, 0x0a, 0x0e, 0x0a, 0x04, 0x05, 0x01, 0x02, 0x15, 0x12, 0x06,
+    0xe6, 0x01, 0x02, 0xeb, 0x01, 0x05, 0x0a, 0x0d, 0x0a, 0x05, 0x05, 0x01, 0x02, 0x15, 0x01, 0x12,
+    0x04, 0xe6, 0x01, 0x02, 0x14, 0x0a, 0x0d, 0x0a, 0x05, 0x05, 0x01, 0x02, 0x15, 0x02, 0x12, 0x04,
+    0xe6, 0x01, 0x17, 0x19, 0x0a, 0x0f, 0x0a, 0x05, 0x05, 0x01, 0x02, 0x15, 0x03, 0x12, 0x06, 0xe6,
+    0x01, 0x1a, 0xeb, 0x01, 0x04, 0x0a, 0x12, 0x0a, 0x08, 0x05, 0x01, 0x02, 0x15, 0x03, 0xb4, 0x87,
+    0x03, 0x12, 0x06, 0xe6, 0x01, 0x1b, 0xeb, 0x01, 0x03, 0x0a, 0x11, 0x0a, 0x09, 0x05, 0x01, 0x02,
+    0x15, 0x03, 0xb4, 0x87, 0x03, 0x01, 0x12, 0x04, 0xe7, 0x01, 0x04, 0x1f, 0x0a, 0x11, 0x0a, 0x09,
+    0x05, 0x01, 0x02, 0x15, 0x03, 0xb4, 0x87, 0x03, 0x02, 0x12, 0x04, 0xe8, 0x01, 0x04, 0x21, 0x0a,
+    0x11, 0x0a, 0x09, 0x05, 0x01, 0x02, 0x15, 0x03, 0xb4, 0x87, 0x03, 0x03, 0x12, 0x04, 0xe9, 0x01,
+    0x04, 0x17, 0x0a, 0x11, 0x0a, 0x09, 0x05, 0x01, 0x02, 0x15, 0x03, 0xb4, 0x87, 0x03, 0x06, 0x12,
+    0x04, 0xea, 0x01, 0x04, 0x12, 0x0a, 0x0e, 0x0a, 0x04, 0x05, 0x01, 0x02, 0x16, 0x12, 0x06, 0xec,
+    0x01, 0x02, 0xf1, 0x01, 0x05, 0x0a, 0x0d, 0x0a, 0x05, 0x05, 0x01, 0x02, 0x16, 0x01, 0x12, 0x04,
+    0xec, 0x01, 0x02, 0x14, 0x0a, 0x0d, 0x0a, 0x05, 0x05, 0x01, 0x02, 0x16, 0x02, 0x12, 0x04, 0xec,
+    0x01, 0x17, 0x19, 0x0a, 0x0f, 0x0a, 0x05, 0x05, 0x01, 0x02, 0x16, 0x03, 0x12, 0x06, 0xec, 0x01,
+    0x1a, 0xf1, 0x01, 0x04, 0x0a, 0x12, 0x0a, 0x08, 0x05, 0x01, 0x02, 0x16, 0x03, 0xb4, 0x87, 0x03,
+    0x12, 0x06, 0xec, 0x01, 0x1b, 0xf1, 0x01, 0x03, 0x0a, 0x11, 0x0a, 0x09, 0x05, 0x01, 0x02, 0x16,
+    0x03, 0xb4, 0x87, 0x03, 0x01, 0x12, 0x04, 0xed, 0x01, 0x04, 0x1f, 0x0a, 0x11, 0x0a, 0x09, 0x05,
+    0x01, 0x02, 0x16, 0x03, 0xb4, 0x87, 0x03, 0x02, 0x12, 0x04, 0xee, 0x01, 0x04, 0x25, 0x0a, 0x11,
+    0x0a, 0x09, 0x05, 0x01, 0x02, 0x16, 0x03, 0xb4, 0x87, 0x03, 0x03, 0x12, 0x04, 0xef, 0x01, 0x04,
+    0x17, 0x0a, 0x11, 0x0a, 0x09, 0x05, 0x01, 0x02, 0x16, 0x03, 0xb4, 0x87, 0x03, 0x06, 0x12, 0x04,
+    0xf0, 0x01, 0x04, 0x12, 0x0a, 0x0e, 0x0a, 0x04, 0x05, 0x01, 0x02, 0x17, 0x12, 0x06, 0xf2, 0x01,
+    0x02, 0xf7, 0x01, 0x05, 0x0a, 0x0d, 0x0a, 0x05, 0x05, 0x01, 0x02, 0x17, 0x01, 0x12, 0x04, 0xf2,
+    0x01, 0x02, 0x0e, 0x0a, 0x0d, 0x0a, 0x05, 0x05, 0x01, 0x02, 0x17, 0x02, 0x12, 0x04, 0xf2, 0x01,
+    0x11, 0x13, 0x0a, 0x0f, 0x0a, 0x05, 0x05, 0x01, 0x02, 0x17, 0x03, 0x12, 0x06, 0xf2, 0x01, 0x14,
+    0xf7, 0x01, 0x04, 0x0a, 0x12, 0x0a, 0x08, 0x05, 0x01, 0x02, 0x17, 0x03, 0xb4, 0x87, 0x03, 0x12,
+    0x06, 0xf2, 0x01, 0x15, 0xf7, 0x01, 0x03, 0x0a, 0x11, 0x0a, 0x09, 0x05, 0x01, 0x02, 0x17, 0x03,
+    0xb4, 0x87, 0x03, 0x01, 0x12, 0x04, 0xf3, 0x01, 0x04, 0x1f, 0x0a, 0x11, 0x0a, 0x09, 0x05, 0x01,
+    0x02, 0x17, 0x03, 0xb4, 0x87, 0x03, 0x02, 0x12, 0x04, 0xf4, 0x01, 0x04, 0x1b, 0x0a, 0x11, 0x0a,
+    0x09, 0x05, 0x01, 0x02, 0x17, 0x03, 0xb4, 0x87, 0x03, 0x03, 0x12, 0x04, 0xf5, 0x01, 0x04, 0x11,
+    0x0a, 0x12, 0x0a, 0x0a, 0x05, 0x01, 0x02, 0x17, 0x03, 0xb4, 0x87, 0x03, 0x04, 0x00, 0x12, 0x04,
+    0xf6, 0x01, 0x0f, 0x15, 0x0a, 0x0e, 0x0a, 0x04, 0x05, 0x01, 0x02, 0x18, 0x12, 0x06, 0xf8, 0x01,
+    0x02, 0xfc, 0x01, 0x05, 0x0a, 0x0d, 0x0a, 0x05, 0x05, 0x01, 0x02, 0x18, 0x01, 0x12, 0x04, 0xf8,
+    0x01, 0x02, 0x0c, 0x0a, 0x0d, 0x0a, 0x05, 0x05, 0x01, 0x02, 0x18, 0x02, 0x12, 0x04, 0xf8, 0x01,
+    0x0f, 0x11, 0x0a, 0x0f, 0x0a, 0x05, 0x05, 0x01, 0x02, 0x18, 0x03, 0x12, 0x06, 0xf8, 0x01, 0x12,
+    0xfc, 0x01, 0x04, 0x0a, 0x12, 0x0a, 0x08, 0x05, 0x01, 0x02, 0x18, 0x03, 0xb4, 0x87, 0x03, 0x12,
+    0x06, 0xf8, 0x01, 0x13, 0xfc, 0x01, 0x03, 0x0a, 0x11, 0x0a, 0x09, 0x05, 0x01, 0x02, 0x18, 0x03,
+    0xb4, 0x87, 0x03, 0x01, 0x12, 0x04, 0xf9, 0x01, 0x04, 0x16, 0x0a, 0x11, 0x0a, 0x09, 0x05, 0x01,
+    0x02, 0x18, 0x03, 0xb4, 0x87, 0x03, 0x02, 0x12, 0x04, 0xfa, 0x01, 0x04, 0x2d, 0x0a, 0x11, 0x0a,
+    0x09, 0x05, 0x01, 0x02, 0x18, 0x03, 0xb4, 0x87, 0x03, 0x06, 0x12, 0x04, 0xfb, 0x01, 0x04, 0x12,
+    0x0a, 0x0e, 0x0a, 0x04, 0x05, 0x01, 0x02, 0x19, 0x12, 0x06, 0xfd, 0x01, 0x02, 0x81, 0x02, 0x05,
+    0x0a, 0x0d, 0x0a, 0x05, 0x05, 0x01, 0x02, 0x19, 0x01, 0x12, 0x04, 0xfd, 0x01, 0x02, 0x0d, 0x0a,
+    0x0d, 0x0a, 0x05, 0x05, 0x01, 0x02, 0x19, 0x02, 0x12, 0x04, 0xfd, 0x01, 0x10, 0x12, 0x0a, 0x0f,
+    0x0a, 0x05, 0x05, 0x01, 0x02, 0x19, 0x03, 0x12, 0x06, 0xfd, 0x01, 0x13, 0x81, 0x02, 0x04, 0x0a,
+    0x12, 0x0a, 0x08, 0x05, 0x01, 0x02, 0x19, 0x03, 0xb4, 0x87, 0x03, 0x12, 0x06, 0xfd, 0x01, 0x14,
+    0x81, 0x02, 0x03, 0x0a, 0x11, 0x0a, 0x09, 0x05, 0x01, 0x02, 0x19, 0x03, 0xb4, 0x87, 0x03, 0x01,
+    0x12, 0x04, 0xfe, 0x01, 0x04, 0x22, 0x0a, 0x11, 0x0a, 0x09, 0x05, 0x01, 0x02, 0x19, 0x03, 0xb4,
+    0x87, 0x03, 0x02, 0x12, 0x04, 0xff, 0x01, 0x04, 0x2d, 0x0a, 0x12, 0x0a, 0x0a, 0x05, 0x01, 0x02,
+    0x19, 0x03, 0xb4, 0x87, 0x03, 0x04, 0x00, 0x12, 0x04, 0x80, 0x02, 0x0f, 0x18, 0x0a, 0x0e, 0x0a,
+    0x04, 0x05, 0x01, 0x02, 0x1a, 0x12, 0x06, 0x82, 0x02, 0x02, 0x86, 0x02, 0x05, 0x0a, 0x0d, 0x0a,
+    0x05, 0x05, 0x01, 0x02, 0x1a, 0x01, 0x12, 0x04, 0x82, 0x02, 0x02, 0x13, 0x0a, 0x0d, 0x0a, 0x05,
+    0x05, 0x01, 0x02, 0x1a, 0x02, 0x12, 0x04, 0x82, 0x02, 0x16, 0x18, 0x0a, 0x0f, 0x0a, 0x05, 0x05,
+    0x01, 0x02, 0x1a, 0x03, 0x12, 0x06, 0x82, 0x02, 0x19, 0x86, 0x02, 0x04, 0x0a, 0x12, 0x0a, 0x08,
+    0x05, 0x01, 0x02, 0x1a, 0x03, 0xb4, 0x87, 0x03, 0x12, 0x06, 0x82, 0x02, 0x1a, 0x86, 0x02, 0x03,
+    0x0a, 0x11, 0x0a, 0x09, 0x05, 0x01, 0x02, 0x1a, 0x03, 0xb4, 0x87, 0x03, 0x01, 0x12, 0x04, 0x83,
+    0x02, 0x04, 0x20, 0x0a, 0x11, 0x0a, 0x09, 0x05, 0x01, 0x02, 0x1a, 0x03, 0xb4, 0x87, 0x03, 0x02,
+    0x12, 0x04, 0x84, 0x02, 0x04, 0x28, 0x0a, 0x11, 0x0a, 0x09, 0x05, 0x01, 0x02, 0x1a, 0x03, 0xb4,
+    0x87, 0x03, 0x06, 0x12, 0x04, 0x85, 0x02, 0x04, 0x12, 0x0a, 0x0e, 0x0a, 0x04, 0x05, 0x01, 0x02,
+    0x1b, 0x12, 0x06, 0x87, 0x02, 0x02, 0x8b, 0x02, 0x05, 0x0a, 0x0d, 0x0a, 0x05, 0x05, 0x01, 0x02,
+    0x1b, 0x01, 0x12, 0x04, 0x87, 0x02, 0x02, 0x12, 0x0a, 0x0d, 0x0a, 0x05, 0x05, 0x01, 0x02, 0x1b,
+    0x02, 0x12, 0x04, 0x87, 0x02, 0x15, 0x17, 0x0a, 0x0f, 0x0a, 0x05, 0x05, 0x01, 0x02, 0x1b, 0x03,
+    0x12, 0x06, 0x87, 0x02, 0x18, 0x8b, 0x02, 0x04, 0x0a, 0x12, 0x0a, 0x08, 0x05, 0x01, 0x02, 0x1b,
+    0x03, 0xb4, 0x87, 0x03, 0x12, 0x06, 0x87, 0x02, 0x19, 0x8b, 0x02, 0x03, 0x0a, 0x11, 0x0a, 0x09,
+    0x05, 0x01, 0x02, 0x1b, 0x03, 0xb4, 0x87, 0x03, 0x01, 0x12, 0x04, 0x88, 0x02, 0x04, 0x18, 0x0a,
+    0x11, 0x0a, 0x09, 0x05, 0x01, 0x02, 0x1b, 0x03, 0xb4, 0x87, 0x03, 0x02, 0x12, 0x04, 0x89, 0x02,
+    0x04, 0x1f, 0x0a, 0x11, 0x0a, 0x09, 0x05, 0x01, 0x02, 0x1b, 0x03, 0xb4, 0x87, 0x03, 0x06, 0x12,
+    0x04, 0x8a, 0x02, 0x04, 0x12, 0x0a, 0x0e, 0x0a, 0x04, 0x05, 0x01, 0x02, 0x1c, 0x12, 0x06, 0x8c,
+    0x02, 0x02, 0x90, 0x02, 0x05, 0x0a, 0x0d, 0x0a, 0x05, 0x05, 0x01, 0x02, 0x1c, 0x01, 0x12, 0x04,
+    0x8c, 0x02, 0x02, 0x15, 0x0a, 0x0d, 0x0a, 0x05, 0x05, 0x01, 0x02, 0x1c, 0x02, 0x12, 0x04, 0x8c,
+    0x02, 0x18, 0x1a, 0x0a, 0x0f, 0x0a, 0x05, 0x05, 0x01, 0x02, 0x1c, 0x03, 0x12, 0x06, 0x8c, 0x02,
+    0x1b, 0x90, 0x02, 0x04, 0x0a, 0x12, 0x0a, 0x08, 0x05, 0x01, 0x02, 0x1c, 0x03, 0xb4, 0x87, 0x03,
+    0x12, 0x06, 0x8c, 0x02, 0x1c, 0x90, 0x02, 0x03, 0x0a, 0x11, 0x0a, 0x09, 0x05, 0x01, 0x02, 0x1c,
+    0x03, 0xb4, 0x87, 0x03, 0x01, 0x12, 0x04, 0x8d, 0x02, 0x04, 0x21, 0x0a, 0x11, 0x0a, 0x09, 0x05,
+    0x01, 0x02, 0x1c, 0x03, 0xb4, 0x87, 0x03, 0x02, 0x12, 0x04, 0x8e, 0x02, 0x04, 0x22, 0x0a, 0x11,
+    0x0a, 0x09, 0x05, 0x01, 0x02, 0x1c, 0x03, 0xb4, 0x87, 0x03, 0x06, 0x12, 0x04, 0x8f, 0x02, 0x04,
+    0x12, 0x0a, 0x0e, 0x0a, 0x04, 0x05, 0x01, 0x02, 0x1d, 0x12, 0x06, 0x91, 0x02, 0x02, 0x95, 0x02,
+    0x05, 0x0a, 0x0d, 0x0a, 0x05, 0x05, 0x01, 0x02, 0x1d, 0x01, 0x12, 0x04, 0x91, 0x02, 0x02, 0x10,
+    0x0a, 0x0d, 0x0a, 0x05, 0x05, 0x01, 0x02, 0x1d, 0x02, 0x12, 0x04, 0x91, 0x02, 0x13, 0x15, 0x0a,
+    0x0f, 0x0a, 0x05, 0x05, 0x01, 0x02, 0x1d, 0x03, 0x12, 0x06, 0x91, 0x02, 0x16, 0x95, 0x02, 0x04,
+    0x0a, 0x12, 0x0a, 0x08, 0x05, 0x01, 0x02, 0x1d, 0x03, 0xb4, 0x87, 0x03, 0x12, 0x06, 0x91, 0x02,
+    0x17, 0x95, 0x02, 0x03, 0x0a, 0x11, 0x0a, 0x09, 0x05, 0x01, 0x02, 0x1d, 0x03, 0xb4, 0x87, 0x03,
+    0x01, 0x12, 0x04, 0x92, 0x02, 0x04, 0x17, 0x0a, 0x11, 0x0a, 0x09, 0x05, 0x01, 0x02, 0x1d, 0x03,
+    0xb4, 0x87, 0x03, 0x02, 0x12, 0x04, 0x93, 0x02, 0x04, 0x1d, 0x0a, 0x11, 0x0a, 0x09, 0x05, 0x01,
+    0x02, 0x1d, 0x03, 0xb4, 0x87, 0x03, 0x06, 0x12, 0x04, 0x94, 0x02, 0x04, 0x12, 0x62, 0x06, 0x70,
+    0x72, 0x6f, 0x74, 0x6f, 0x33,
 ];
 include!("hello_world.tonic.rs");
 // @@protoc_insertion_point(module)
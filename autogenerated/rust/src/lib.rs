@@ -1,4 +1,16 @@
 // @generated
+#[cfg(feature = "errorspec")]
+// @@protoc_insertion_point(attribute:errorspec)
+pub mod errorspec {
+    include!("errorspec.rs");
+    // @@protoc_insertion_point(errorspec)
+}
+#[cfg(feature = "fieldspec")]
+// @@protoc_insertion_point(attribute:fieldspec)
+pub mod fieldspec {
+    include!("fieldspec.rs");
+    // @@protoc_insertion_point(fieldspec)
+}
 #[cfg(feature = "hello_world")]
 // @@protoc_insertion_point(attribute:hello_world)
 pub mod hello_world {
@@ -0,0 +1,254 @@
+// compat-report sends a corpus of CreateUser and CreateUserAlt requests to
+// the Go and the Rust server and reports, as markdown, where the errors they
+// answer with differ in code, reason or field violations.
+//
+// The corpus creates users, so both servers should be freshly started. The
+// {method} placeholder in usernames and emails keeps the users created
+// through each method apart.
+package main
+
+import (
+	"context"
+	_ "embed"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"strings"
+	"time"
+
+	helloworldPb "github.com/amirsalarsafaei/proto-error-handling/autogenerated/go/helloworld"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+
+	"github.com/amirsalarsafaei/proto-error-handling/go/pkg/statusdetails"
+)
+
+const callTimeout = 5 * time.Second
+
+//go:embed corpus.json
+var defaultCorpus []byte
+
+type request struct {
+	Name     string `json:"name"`
+	Username string `json:"username"`
+	Email    string `json:"email"`
+}
+
+func (r request) proto(method string) *helloworldPb.CreateUserRequest {
+	tag := strings.ToLower(method[len(method)-1:])
+	return &helloworldPb.CreateUserRequest{
+		Username: strings.ReplaceAll(r.Username, "{method}", tag),
+		Email:    strings.ReplaceAll(r.Email, "{method}", tag),
+	}
+}
+
+// outcome is what a call is compared by.
+type outcome struct {
+	Code codes.Code
+	// InBand is set for errors in a CreateUserAlt response.
+	InBand bool
+	Reason string
+	Fields []string
+}
+
+func (o outcome) String() string {
+	var parts []string
+	if o.InBand {
+		parts = append(parts, "in-band")
+	} else {
+		parts = append(parts, o.Code.String())
+	}
+	if o.Reason != "" {
+		parts = append(parts, o.Reason)
+	}
+	if len(o.Fields) > 0 {
+		parts = append(parts, "["+strings.Join(o.Fields, ", ")+"]")
+	}
+	return strings.Join(parts, " ")
+}
+
+// differences names what differs between two outcomes.
+func (o outcome) differences(other outcome) []string {
+	var diffs []string
+	if o.Code != other.Code || o.InBand != other.InBand {
+		diffs = append(diffs, "code")
+	}
+	if o.Reason != other.Reason {
+		diffs = append(diffs, "reason")
+	}
+	if !slices.Equal(o.Fields, other.Fields) {
+		diffs = append(diffs, "fields")
+	}
+	return diffs
+}
+
+type result struct {
+	request request
+	method  string
+	goOut   outcome
+	rustOut outcome
+}
+
+func main() {
+	goAddr := flag.String("go", "127.0.0.1:8000", "Address of the Go server")
+	rustAddr := flag.String("rust", "[::1]:8000", "Address of the Rust server")
+	corpusPath := flag.String("corpus", "", "JSON file of requests to send (defaults to the embedded corpus)")
+	output := flag.String("o", "", "File to write the report to (defaults to stdout)")
+	strict := flag.Bool("strict", false, "Exit with status 1 if the servers differ")
+	flag.Parse()
+
+	corpus, err := loadCorpus(*corpusPath)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "could not load corpus:", err)
+		os.Exit(1)
+	}
+
+	goClient, err := newClient(*goAddr)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "could not create Go client:", err)
+		os.Exit(1)
+	}
+	rustClient, err := newClient(*rustAddr)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "could not create Rust client:", err)
+		os.Exit(1)
+	}
+
+	var results []result
+	for _, method := range []string{"CreateUser", "CreateUserAlt"} {
+		for _, r := range corpus {
+			goOut, err := call(goClient, method, r.proto(method))
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "%s %s on the Go server: %v\n", method, r.Name, err)
+				os.Exit(1)
+			}
+			rustOut, err := call(rustClient, method, r.proto(method))
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "%s %s on the Rust server: %v\n", method, r.Name, err)
+				os.Exit(1)
+			}
+			results = append(results, result{request: r, method: method, goOut: goOut, rustOut: rustOut})
+		}
+	}
+
+	w := io.Writer(os.Stdout)
+	if *output != "" {
+		f, err := os.Create(*output)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, "could not create report:", err)
+			os.Exit(1)
+		}
+		defer f.Close()
+		w = f
+	}
+
+	compatible := writeReport(w, *goAddr, *rustAddr, results)
+	if *strict && compatible < len(results) {
+		os.Exit(1)
+	}
+}
+
+func loadCorpus(path string) ([]request, error) {
+	data := defaultCorpus
+	if path != "" {
+		var err error
+		if data, err = os.ReadFile(path); err != nil {
+			return nil, err
+		}
+	}
+
+	var corpus []request
+	if err := json.Unmarshal(data, &corpus); err != nil {
+		return nil, err
+	}
+	return corpus, nil
+}
+
+func newClient(addr string) (helloworldPb.UserServiceClient, error) {
+	conn, err := grpc.NewClient(addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return nil, err
+	}
+	return helloworldPb.NewUserServiceClient(conn), nil
+}
+
+// call makes the call and returns its outcome. It only fails if the server
+// can't be reached.
+func call(client helloworldPb.UserServiceClient, method string, request *helloworldPb.CreateUserRequest) (outcome, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), callTimeout)
+	defer cancel()
+
+	var err error
+	if method == "CreateUser" {
+		_, err = client.CreateUser(ctx, request)
+	} else {
+		var response *helloworldPb.CreateUserAltResponse
+		response, err = client.CreateUserAlt(ctx, request)
+		if details := response.GetError(); err == nil && details != nil {
+			return inBandOutcome(details), nil
+		}
+	}
+
+	if code := status.Code(err); code == codes.Unavailable || code == codes.DeadlineExceeded {
+		return outcome{}, err
+	}
+	return statusOutcome(err), nil
+}
+
+func statusOutcome(err error) outcome {
+	richErr, ok := statusdetails.FromError(err)
+	if !ok {
+		return outcome{Code: status.Code(err)}
+	}
+
+	o := outcome{Code: richErr.Status().Code()}
+	if info, ok := richErr.ErrorInfo(); ok {
+		o.Reason = info.Reason
+	}
+	for _, v := range richErr.BadRequestViolations() {
+		o.Fields = append(o.Fields, v.GetField())
+	}
+	slices.Sort(o.Fields)
+	return o
+}
+
+func inBandOutcome(details *helloworldPb.ErrorDetails) outcome {
+	o := outcome{InBand: true, Reason: details.GetCode().String()}
+	for _, v := range details.GetFieldViolations() {
+		o.Fields = append(o.Fields, v.GetField())
+	}
+	slices.Sort(o.Fields)
+	return o
+}
+
+// writeReport writes the report as markdown and returns how many calls had
+// the same outcome on both servers.
+func writeReport(w io.Writer, goAddr, rustAddr string, results []result) int {
+	var compatible int
+	var rows strings.Builder
+	for _, r := range results {
+		diffs := r.goOut.differences(r.rustOut)
+		verdict := "same"
+		if len(diffs) > 0 {
+			verdict = "differs in " + strings.Join(diffs, ", ")
+		} else {
+			compatible++
+		}
+		fmt.Fprintf(&rows, "| %s | %s | %s | %s | %s |\n", r.method, r.request.Name, r.goOut, r.rustOut, verdict)
+	}
+
+	fmt.Fprintln(w, "# Go/Rust error compatibility")
+	fmt.Fprintln(w)
+	fmt.Fprintf(w, "Go server at `%s`, Rust server at `%s`.\n", goAddr, rustAddr)
+	fmt.Fprintf(w, "%d of %d calls fail or succeed the same way on both servers.\n", compatible, len(results))
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "| Method | Request | Go | Rust | |")
+	fmt.Fprintln(w, "|---|---|---|---|---|")
+	fmt.Fprint(w, rows.String())
+	return compatible
+}
@@ -0,0 +1,324 @@
+// protoc-gen-go-errors generates the Go side of the error contract declared
+// with the errorspec options: for every enum with an errorspec.domain, a
+// sentinel and a constructor per reason, the statusdetails mappings of the
+// reasons and a decoder for status errors of the domain.
+//
+// The code is generated into the package given by the import_path parameter,
+// which uses the pkg/apperrors and pkg/statusdetails packages of this module.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"go/token"
+	"path"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/pluginpb"
+
+	"github.com/amirsalarsafaei/proto-error-handling/autogenerated/go/errorspec"
+)
+
+const (
+	apperrorsPackage     = "github.com/amirsalarsafaei/proto-error-handling/go/pkg/apperrors"
+	statusdetailsPackage = "github.com/amirsalarsafaei/proto-error-handling/go/pkg/statusdetails"
+)
+
+var initialisms = map[string]string{
+	"API":  "API",
+	"ETAG": "ETag",
+	"HTTP": "HTTP",
+	"ID":   "ID",
+	"JSON": "JSON",
+	"URL":  "URL",
+	"UUID": "UUID",
+}
+
+func main() {
+	var flags flag.FlagSet
+	importPath := flags.String("import_path", "", "Go import path of the package to generate into")
+
+	protogen.Options{ParamFunc: flags.Set}.Run(func(gen *protogen.Plugin) error {
+		gen.SupportedFeatures = uint64(pluginpb.CodeGeneratorResponse_FEATURE_PROTO3_OPTIONAL)
+		return generate(gen, *importPath)
+	})
+}
+
+func generate(gen *protogen.Plugin, importPath string) error {
+	if importPath == "" {
+		return fmt.Errorf("import_path parameter is required")
+	}
+
+	for _, file := range gen.Files {
+		if !file.Generate {
+			continue
+		}
+		enums := errorEnums(file)
+		if len(enums) == 0 {
+			continue
+		}
+		g := gen.NewGeneratedFile(file.GeneratedFilenamePrefix+"_errors.pb.go", protogen.GoImportPath(importPath))
+		if err := generateFile(g, file, path.Base(importPath), enums); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+type errorEnum struct {
+	enum    *protogen.Enum
+	domain  string
+	reasons []errorReason
+}
+
+type errorReason struct {
+	value *protogen.EnumValue
+	spec  *errorspec.ErrorSpec
+}
+
+func errorEnums(file *protogen.File) []errorEnum {
+	var enums []errorEnum
+	for _, enum := range file.Enums {
+		domain := proto.GetExtension(enum.Desc.Options(), errorspec.E_Domain).(string)
+		if domain == "" {
+			continue
+		}
+
+		e := errorEnum{enum: enum, domain: domain}
+		for _, value := range enum.Values {
+			spec := proto.GetExtension(value.Desc.Options(), errorspec.E_Error).(*errorspec.ErrorSpec)
+			if spec == nil {
+				continue
+			}
+			e.reasons = append(e.reasons, errorReason{value: value, spec: spec})
+		}
+		enums = append(enums, e)
+	}
+	return enums
+}
+
+func generateFile(g *protogen.GeneratedFile, file *protogen.File, packageName string, enums []errorEnum) error {
+	pb := string(file.GoPackageName) + "Pb"
+
+	var sentinels bool
+	for _, e := range enums {
+		for _, reason := range e.reasons {
+			sentinels = sentinels || !reason.spec.GetAggregate() && !reason.spec.GetExternal()
+		}
+	}
+
+	g.P("// Code generated by protoc-gen-go-errors. DO NOT EDIT.")
+	g.P("// source: ", file.Desc.Path())
+	g.P()
+	g.P("package ", packageName)
+	g.P()
+	g.P("import (")
+	if sentinels {
+		g.P(`	"errors"`)
+		g.P()
+	}
+	g.P(`	"google.golang.org/grpc/codes"`)
+	g.P()
+	g.P("	", pb, " ", fmt.Sprintf("%q", string(file.GoImportPath)))
+	g.P()
+	if sentinels {
+		g.P(`	"`, apperrorsPackage, `"`)
+	}
+	g.P(`	"`, statusdetailsPackage, `"`)
+	g.P(")")
+
+	for _, e := range enums {
+		if err := generateEnum(g, pb, e); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func generateEnum(g *protogen.GeneratedFile, pb string, e errorEnum) error {
+	name := e.enum.GoIdent.GoName
+	qualified := func(value *protogen.EnumValue) string {
+		return pb + "." + value.GoIdent.GoName
+	}
+
+	var aggregate *errorReason
+	var owned []errorReason
+	for i, reason := range e.reasons {
+		switch {
+		case reason.spec.GetAggregate():
+			if aggregate != nil {
+				return fmt.Errorf("%s: both %s and %s are declared as aggregate",
+					e.enum.Desc.FullName(), aggregate.value.Desc.Name(), reason.value.Desc.Name())
+			}
+			aggregate = &e.reasons[i]
+		case !reason.spec.GetExternal():
+			owned = append(owned, reason)
+		}
+	}
+
+	g.P()
+	g.P("// ", name, "Domain is the ErrorInfo domain of every ", name, ".")
+	g.P("const ", name, "Domain = ", fmt.Sprintf("%q", e.domain))
+
+	if len(owned) > 0 {
+		g.P()
+		g.P("var (")
+		for _, reason := range owned {
+			g.P("	", sentinelName(reason), " = errors.New(", fmt.Sprintf("%q", sentenceCase(reason.spec.GetMessage())), ")")
+		}
+		g.P(")")
+	}
+
+	for _, reason := range owned {
+		params := make([]string, 0, len(reason.spec.GetMetadata()))
+		for _, key := range reason.spec.GetMetadata() {
+			params = append(params, paramName(key))
+		}
+
+		g.P()
+		g.P("// New", camelCase(string(reason.value.Desc.Name())), "Error returns ", sentinelName(reason), " as a DomainError.")
+		if len(params) > 0 {
+			g.P("func New", camelCase(string(reason.value.Desc.Name())), "Error(", strings.Join(params, ", "), " string) *apperrors.DomainError {")
+		} else {
+			g.P("func New", camelCase(string(reason.value.Desc.Name())), "Error() *apperrors.DomainError {")
+		}
+		g.P("	return apperrors.New(", sentinelName(reason), ")", chain(len(params) > 0))
+		for i, key := range reason.spec.GetMetadata() {
+			g.P("		WithMetadata(", fmt.Sprintf("%q", key), ", ", params[i], ")", chain(i < len(params)-1))
+		}
+		g.P("}")
+	}
+
+	g.P()
+	g.P("var ", lowerFirst(name), "Mappings = map[", pb, ".", name, "]statusdetails.ErrorMapping{")
+	for _, reason := range e.reasons {
+		g.P("	", qualified(reason.value), ": {")
+		g.P("		Code:    codes.", codes.Code(reason.spec.GetCode()).String(), ",")
+		g.P("		Reason:  ", qualified(reason.value), ".String(),")
+		if field := reason.spec.GetField(); field != "" {
+			g.P("		Field:   ", fmt.Sprintf("%q", field), ",")
+		}
+		g.P("		Message: ", fmt.Sprintf("%q", reason.spec.GetMessage()), ",")
+		g.P("	},")
+	}
+	g.P("}")
+
+	g.P()
+	g.P("// ", name, "Mapping returns the mapping declared for reason.")
+	g.P("func ", name, "Mapping(reason ", pb, ".", name, ") (statusdetails.ErrorMapping, bool) {")
+	g.P("	m, ok := ", lowerFirst(name), "Mappings[reason]")
+	g.P("	return m, ok")
+	g.P("}")
+
+	g.P()
+	g.P("var ", lowerFirst(name), "Sentinels = map[", pb, ".", name, "]error{")
+	for _, reason := range owned {
+		g.P("	", qualified(reason.value), ": ", sentinelName(reason), ",")
+	}
+	g.P("}")
+
+	g.P()
+	g.P("// ", name, "Sentinel returns the sentinel of reason. Aggregate and external")
+	g.P("// reasons have none.")
+	g.P("func ", name, "Sentinel(reason ", pb, ".", name, ") (error, bool) {")
+	g.P("	err, ok := ", lowerFirst(name), "Sentinels[reason]")
+	g.P("	return err, ok")
+	g.P("}")
+
+	g.P()
+	g.P("// Register", name, "s registers the sentinel of every ", name, " with registry.")
+	g.P("// Reasons declared as external are left to the caller, to be registered")
+	g.P("// for errors from outside this package.")
+	g.P("func Register", name, "s(registry *statusdetails.Registry) *statusdetails.Registry {")
+	g.P("	return registry", chain(aggregate != nil || len(owned) > 0))
+	if aggregate != nil {
+		g.P("		SetAggregate(", lowerFirst(name), "Mappings[", qualified(aggregate.value), "])", chain(len(owned) > 0))
+	}
+	for i, reason := range owned {
+		g.P("		Register(", sentinelName(reason), ", ", lowerFirst(name), "Mappings[", qualified(reason.value), "])", chain(i < len(owned)-1))
+	}
+	g.P("}")
+
+	g.P()
+	g.P("// Decode", name, " returns the ", name, " of a status error from ", name, "Domain.")
+	g.P("func Decode", name, "(err error) (", pb, ".", name, ", bool) {")
+	g.P("	richErr, ok := statusdetails.FromError(err)")
+	g.P("	if !ok {")
+	g.P("		return ", qualified(e.enum.Values[0]), ", false")
+	g.P("	}")
+	g.P("	info, ok := richErr.ErrorInfo()")
+	g.P("	if !ok || info.Domain != ", name, "Domain {")
+	g.P("		return ", qualified(e.enum.Values[0]), ", false")
+	g.P("	}")
+	g.P("	return Parse", name, "(info.Reason)")
+	g.P("}")
+
+	g.P()
+	g.P("// Parse", name, " returns the ", name, " named reason.")
+	g.P("func Parse", name, "(reason string) (", pb, ".", name, ", bool) {")
+	g.P("	value, ok := ", pb, ".", name, "_value[reason]")
+	g.P("	return ", pb, ".", name, "(value), ok")
+	g.P("}")
+	return nil
+}
+
+func chain(more bool) string {
+	if more {
+		return "."
+	}
+	return ""
+}
+
+func sentinelName(reason errorReason) string {
+	return "Err" + camelCase(string(reason.value.Desc.Name()))
+}
+
+// camelCase converts a SCREAMING_SNAKE_CASE name to CamelCase, keeping the
+// Go spelling of initialisms.
+func camelCase(name string) string {
+	var b strings.Builder
+	for _, word := range strings.Split(name, "_") {
+		if initialism, ok := initialisms[strings.ToUpper(word)]; ok {
+			b.WriteString(initialism)
+			continue
+		}
+		if word == "" {
+			continue
+		}
+		b.WriteString(strings.ToUpper(word[:1]) + strings.ToLower(word[1:]))
+	}
+	return b.String()
+}
+
+func paramName(key string) string {
+	words := strings.SplitN(key, "_", 2)
+	name := strings.ToLower(words[0])
+	if len(words) > 1 {
+		name += camelCase(words[1])
+	}
+	if token.IsKeyword(name) {
+		name += "_"
+	}
+	return name
+}
+
+func lowerFirst(s string) string {
+	r, size := utf8.DecodeRuneInString(s)
+	return string(unicode.ToLower(r)) + s[size:]
+}
+
+// sentenceCase lowercases the first letter of a message for use as an error
+// string, unless it starts an initialism.
+func sentenceCase(message string) string {
+	first, size := utf8.DecodeRuneInString(message)
+	second, _ := utf8.DecodeRuneInString(message[size:])
+	if unicode.IsUpper(second) {
+		return message
+	}
+	return string(unicode.ToLower(first)) + message[size:]
+}
@@ -0,0 +1,154 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"strings"
+	"testing"
+
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/pluginpb"
+
+	"github.com/amirsalarsafaei/proto-error-handling/autogenerated/go/errorspec"
+	helloworldPb "github.com/amirsalarsafaei/proto-error-handling/autogenerated/go/helloworld"
+)
+
+const importPath = "github.com/amirsalarsafaei/proto-error-handling/go/pkg/helloworld"
+
+// request asks to generate file, listing the files it imports before it the
+// way protoc does.
+func request(file protoreflect.FileDescriptor) *pluginpb.CodeGeneratorRequest {
+	req := &pluginpb.CodeGeneratorRequest{
+		FileToGenerate: []string{file.Path()},
+		Parameter:      proto.String("paths=source_relative,import_path=" + importPath),
+	}
+	seen := make(map[string]bool)
+	var add func(protoreflect.FileDescriptor)
+	add = func(f protoreflect.FileDescriptor) {
+		if seen[f.Path()] {
+			return
+		}
+		seen[f.Path()] = true
+		for i := 0; i < f.Imports().Len(); i++ {
+			add(f.Imports().Get(i).FileDescriptor)
+		}
+		req.ProtoFile = append(req.ProtoFile, protodesc.ToFileDescriptorProto(f))
+	}
+	add(file)
+	return req
+}
+
+func run(t *testing.T, req *pluginpb.CodeGeneratorRequest) (*pluginpb.CodeGeneratorResponse, error) {
+	t.Helper()
+	gen, err := protogen.Options{}.New(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := generate(gen, importPath); err != nil {
+		return nil, err
+	}
+	response := gen.Response()
+	if response.Error != nil {
+		t.Fatal(response.GetError())
+	}
+	return response, nil
+}
+
+func TestGenerateHelloworld(t *testing.T) {
+	response, err := run(t, request(helloworldPb.File_helloworld_helloworld_proto))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(response.File) != 1 || response.File[0].GetName() != "helloworld/helloworld_errors.pb.go" {
+		t.Fatalf("got files %v, want helloworld/helloworld_errors.pb.go", response.File)
+	}
+
+	want, err := os.ReadFile("../../pkg/helloworld/helloworld_errors.pb.go")
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := []byte(response.File[0].GetContent())
+	if !bytes.Equal(got, want) {
+		gotLines, wantLines := strings.Split(string(got), "\n"), strings.Split(string(want), "\n")
+		for i := 0; i < len(gotLines) && i < len(wantLines); i++ {
+			if gotLines[i] != wantLines[i] {
+				t.Fatalf("pkg/helloworld/helloworld_errors.pb.go is out of date, run make buf-generate: line %d is %q, want %q",
+					i+1, wantLines[i], gotLines[i])
+			}
+		}
+		t.Fatalf("pkg/helloworld/helloworld_errors.pb.go is out of date, run make buf-generate: got %d lines, want %d",
+			len(wantLines), len(gotLines))
+	}
+}
+
+func TestGenerateTwoAggregates(t *testing.T) {
+	aggregate := func(name string, number int32) *descriptorpb.EnumValueDescriptorProto {
+		options := &descriptorpb.EnumValueOptions{}
+		proto.SetExtension(options, errorspec.E_Error, &errorspec.ErrorSpec{
+			Code:      errorspec.Code_CODE_INVALID_ARGUMENT,
+			Message:   "Invalid request",
+			Aggregate: true,
+		})
+		return &descriptorpb.EnumValueDescriptorProto{Name: proto.String(name), Number: proto.Int32(number), Options: options}
+	}
+	enumOptions := &descriptorpb.EnumOptions{}
+	proto.SetExtension(enumOptions, errorspec.E_Domain, "example.com")
+
+	file := &descriptorpb.FileDescriptorProto{
+		Name:       proto.String("example/example.proto"),
+		Package:    proto.String("example"),
+		Syntax:     proto.String("proto3"),
+		Dependency: []string{"errorspec/errorspec.proto"},
+		Options:    &descriptorpb.FileOptions{GoPackage: proto.String("example.com/example;example")},
+		EnumType: []*descriptorpb.EnumDescriptorProto{{
+			Name: proto.String("Reason"),
+			Value: []*descriptorpb.EnumValueDescriptorProto{
+				{Name: proto.String("REASON_UNSPECIFIED"), Number: proto.Int32(0)},
+				aggregate("FIRST", 1),
+				aggregate("SECOND", 2),
+			},
+			Options: enumOptions,
+		}},
+	}
+	req := request(errorspec.File_errorspec_errorspec_proto)
+	req.ProtoFile = append(req.ProtoFile, file)
+	req.FileToGenerate = []string{file.GetName()}
+
+	_, err := run(t, req)
+	if err == nil || !strings.Contains(err.Error(), "both FIRST and SECOND are declared as aggregate") {
+		t.Errorf("got error %v, want one naming both aggregate reasons", err)
+	}
+}
+
+func TestNames(t *testing.T) {
+	tests := []struct {
+		f    func(string) string
+		in   string
+		want string
+	}{
+		{camelCase, "DUPLICATE_EMAIL", "DuplicateEmail"},
+		{camelCase, "INVALID_USER_ID", "InvalidUserID"},
+		{camelCase, "INVALID_ETAG", "InvalidETag"},
+		{camelCase, "API_URL_TOO_LONG", "APIURLTooLong"},
+		{camelCase, "TRAILING__UNDERSCORES_", "TrailingUnderscores"},
+		{paramName, "email", "email"},
+		{paramName, "user_id", "userID"},
+		{paramName, "request_etag", "requestETag"},
+		{paramName, "type", "type_"},
+		{paramName, "func", "func_"},
+		{paramName, "default", "default_"},
+		{sentenceCase, "Email already in use", "email already in use"},
+		{sentenceCase, "ETag does not match", "ETag does not match"},
+		{sentenceCase, "ID must be a UUID", "ID must be a UUID"},
+		{sentenceCase, "A user", "a user"},
+	}
+	for _, tt := range tests {
+		if got := tt.f(tt.in); got != tt.want {
+			t.Errorf("got %q for %q, want %q", got, tt.in, tt.want)
+		}
+	}
+}
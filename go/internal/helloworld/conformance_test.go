@@ -0,0 +1,38 @@
+package helloworld
+
+import (
+	"testing"
+	"time"
+
+	helloworldPb "github.com/amirsalarsafaei/proto-error-handling/autogenerated/go/helloworld"
+
+	"github.com/amirsalarsafaei/proto-error-handling/go/pkg/conformance"
+	"github.com/amirsalarsafaei/proto-error-handling/go/pkg/golden"
+)
+
+// TestConformance runs the conformance suite against the user service with
+// each of its repositories, checking the errors of every case against the
+// golden files in testdata/conformance. Run it with -update to rewrite them.
+func TestConformance(t *testing.T) {
+	dir := golden.NewDir("testdata/conformance")
+
+	repositories := []struct {
+		name       string
+		newService func(t *testing.T) helloworldPb.UserServiceServer
+	}{
+		{"in-memory", func(t *testing.T) helloworldPb.UserServiceServer {
+			return NewUserService(NewInMemoryUserRepository(), NewInMemoryIdempotencyStore(time.Hour))
+		}},
+		{"sqlite", func(t *testing.T) helloworldPb.UserServiceServer {
+			db := openTestDatabase(t)
+			return NewUserService(NewSQLUserRepository(db), NewSQLIdempotencyStore(db, time.Hour))
+		}},
+	}
+	for _, repository := range repositories {
+		t.Run(repository.name, func(t *testing.T) {
+			conformance.Run(t, func(t *testing.T) helloworldPb.UserServiceClient {
+				return conformance.Serve(t, repository.newService(t))
+			}, conformance.WithGolden(dir))
+		})
+	}
+}
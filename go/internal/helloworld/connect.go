@@ -0,0 +1,94 @@
+package helloworld
+
+import (
+	"context"
+	"net/http"
+
+	"connectrpc.com/connect"
+	connectcors "connectrpc.com/cors"
+	helloworldPb "github.com/amirsalarsafaei/proto-error-handling/autogenerated/go/helloworld"
+	"github.com/amirsalarsafaei/proto-error-handling/autogenerated/go/helloworld/helloworldconnect"
+	"github.com/rs/cors"
+)
+
+type connectUserService struct {
+	server helloworldPb.UserServiceServer
+}
+
+// NewConnectUserService serves server with connect-go, which speaks the
+// Connect and gRPC-Web protocols as well as gRPC. Errors are returned as is,
+// for interceptors.NewConnectInterceptor to convert.
+func NewConnectUserService(server helloworldPb.UserServiceServer) helloworldconnect.UserServiceHandler {
+	return &connectUserService{server: server}
+}
+
+func (s *connectUserService) CreateUser(
+	ctx context.Context,
+	request *connect.Request[helloworldPb.CreateUserRequest],
+) (*connect.Response[helloworldPb.CreateUserResponse], error) {
+	return connectUnary(ctx, request, s.server.CreateUser)
+}
+
+func (s *connectUserService) CreateUserAlt(
+	ctx context.Context,
+	request *connect.Request[helloworldPb.CreateUserRequest],
+) (*connect.Response[helloworldPb.CreateUserAltResponse], error) {
+	return connectUnary(ctx, request, s.server.CreateUserAlt)
+}
+
+func (s *connectUserService) GetUser(
+	ctx context.Context,
+	request *connect.Request[helloworldPb.GetUserRequest],
+) (*connect.Response[helloworldPb.GetUserResponse], error) {
+	return connectUnary(ctx, request, s.server.GetUser)
+}
+
+func (s *connectUserService) UpdateUser(
+	ctx context.Context,
+	request *connect.Request[helloworldPb.UpdateUserRequest],
+) (*connect.Response[helloworldPb.UpdateUserResponse], error) {
+	return connectUnary(ctx, request, s.server.UpdateUser)
+}
+
+func (s *connectUserService) DeleteUser(
+	ctx context.Context,
+	request *connect.Request[helloworldPb.DeleteUserRequest],
+) (*connect.Response[helloworldPb.DeleteUserResponse], error) {
+	return connectUnary(ctx, request, s.server.DeleteUser)
+}
+
+func (s *connectUserService) ListUsers(
+	ctx context.Context,
+	request *connect.Request[helloworldPb.ListUsersRequest],
+) (*connect.Response[helloworldPb.ListUsersResponse], error) {
+	return connectUnary(ctx, request, s.server.ListUsers)
+}
+
+func connectUnary[Req, Res any](
+	ctx context.Context,
+	request *connect.Request[Req],
+	method func(context.Context, *Req) (*Res, error),
+) (*connect.Response[Res], error) {
+	response, err := method(ctx, request.Msg)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(response), nil
+}
+
+// WithCORS lets pages from origins call handler with the Connect and gRPC-Web
+// protocols, and read the headers that carry the details of errors. "*"
+// allows every origin. Without origins, handler is left to pages of its own
+// origin.
+func WithCORS(handler http.Handler, origins []string) http.Handler {
+	if len(origins) == 0 {
+		return handler
+	}
+	return cors.New(cors.Options{
+		AllowedOrigins: origins,
+		AllowedMethods: connectcors.AllowedMethods(),
+		AllowedHeaders: connectcors.AllowedHeaders(),
+		ExposedHeaders: connectcors.ExposedHeaders(),
+		MaxAge:         7200,
+	}).Handler(handler)
+}
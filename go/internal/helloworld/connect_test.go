@@ -0,0 +1,468 @@
+package helloworld_test
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	helloworldPb "github.com/amirsalarsafaei/proto-error-handling/autogenerated/go/helloworld"
+	"github.com/amirsalarsafaei/proto-error-handling/autogenerated/go/helloworld/helloworldconnect"
+	"golang.org/x/net/http2"
+	"golang.org/x/net/http2/h2c"
+	spb "google.golang.org/genproto/googleapis/rpc/status"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/amirsalarsafaei/proto-error-handling/go/internal/helloworld"
+	helloworldErrors "github.com/amirsalarsafaei/proto-error-handling/go/pkg/helloworld"
+	"github.com/amirsalarsafaei/proto-error-handling/go/pkg/interceptors"
+	"github.com/amirsalarsafaei/proto-error-handling/go/pkg/ratelimit"
+	"github.com/amirsalarsafaei/proto-error-handling/go/pkg/statusdetails"
+)
+
+const (
+	callerHeader = "x-caller-id"
+	// pageOrigin is the origin of the pages allowed to call the connect-go
+	// server.
+	pageOrigin = "https://app.example.com"
+	// brokenUsername makes the user repository fail, for the internal error
+	// path.
+	brokenUsername = "broken"
+)
+
+type createUserFunc func(ctx context.Context, header http.Header, request *helloworldPb.CreateUserRequest) error
+
+type protocol struct {
+	name       string
+	createUser createUserFunc
+}
+
+type errorCase struct {
+	name   string
+	header http.Header
+	// request is the request of the case over protocol, which keeps request
+	// IDs and callers apart between protocols.
+	request func(protocol string) *helloworldPb.CreateUserRequest
+	// setup prepares the server for the case over protocol.
+	setup  func(ctx context.Context, server *server, protocol string) error
+	code   codes.Code
+	reason string
+}
+
+// TestProtocols checks that every error path of CreateUser reaches clients
+// intact over each protocol the server speaks: gRPC, and gRPC, gRPC-Web and
+// Connect as served by connect-go. It compares the status every client
+// decodes with the status the server sent.
+func TestProtocols(t *testing.T) {
+	ctx := context.Background()
+
+	// the internal error case is logged as unhandled, which is expected here
+	logger := slog.Default()
+	slog.SetDefault(slog.New(slog.NewTextHandler(io.Discard, nil)))
+	t.Cleanup(func() { slog.SetDefault(logger) })
+
+	srv := newServer(t)
+	for _, c := range errorCases() {
+		for _, p := range srv.protocols() {
+			t.Run(c.name+"/"+p.name, func(t *testing.T) {
+				if err := check(ctx, srv, c, p); err != nil {
+					t.Error(err)
+				}
+			})
+		}
+	}
+}
+
+// TestCORS checks that pages of an allowed origin can call the connect-go
+// server with the Connect and gRPC-Web protocols, and read the details of
+// its errors.
+func TestCORS(t *testing.T) {
+	srv := newServer(t)
+	url := srv.connectServer.URL + helloworldconnect.UserServiceCreateUserProcedure
+
+	for _, headers := range []string{"connect-protocol-version,content-type", "content-type,x-grpc-web,x-user-agent"} {
+		preflight, err := http.NewRequest(http.MethodOptions, url, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		preflight.Header.Set("Origin", pageOrigin)
+		preflight.Header.Set("Access-Control-Request-Method", http.MethodPost)
+		preflight.Header.Set("Access-Control-Request-Headers", headers)
+		response, err := http.DefaultClient.Do(preflight)
+		if err != nil {
+			t.Fatal(err)
+		}
+		response.Body.Close()
+		if got := response.Header.Get("Access-Control-Allow-Origin"); got != pageOrigin {
+			t.Errorf("preflight of %s: got allowed origin %q, want %q", headers, got, pageOrigin)
+		}
+	}
+
+	call := func(origin string) *http.Response {
+		request, err := http.NewRequest(http.MethodPost, url, strings.NewReader(`{"username": "", "email": "user@example.com"}`))
+		if err != nil {
+			t.Fatal(err)
+		}
+		request.Header.Set("Content-Type", "application/json")
+		request.Header.Set("Origin", origin)
+		response, err := http.DefaultClient.Do(request)
+		if err != nil {
+			t.Fatal(err)
+		}
+		response.Body.Close()
+		return response
+	}
+
+	response := call(pageOrigin)
+	exposed := strings.ToLower(response.Header.Get("Access-Control-Expose-Headers"))
+	for _, header := range []string{"grpc-status", "grpc-message", "grpc-status-details-bin"} {
+		if !strings.Contains(exposed, header) {
+			t.Errorf("%s is not exposed, got %q", header, exposed)
+		}
+	}
+
+	if got := call("https://elsewhere.example.com").Header.Get("Access-Control-Allow-Origin"); got != "" {
+		t.Errorf("got allowed origin %q for another origin", got)
+	}
+}
+
+func errorCases() []errorCase {
+	user := func(username, email string) func(string) *helloworldPb.CreateUserRequest {
+		return func(string) *helloworldPb.CreateUserRequest {
+			return &helloworldPb.CreateUserRequest{Username: username, Email: email}
+		}
+	}
+
+	return []errorCase{
+		{
+			name:    "empty username",
+			request: user("", "user@example.com"),
+			code:    codes.InvalidArgument,
+			reason:  "VALIDATION_FAILED",
+		},
+		{
+			name:    "username too long",
+			request: user(strings.Repeat("a", 51), "user@example.com"),
+			code:    codes.InvalidArgument,
+			reason:  "VALIDATION_FAILED",
+		},
+		{
+			name:    "empty email",
+			request: user("user", ""),
+			code:    codes.InvalidArgument,
+			reason:  "VALIDATION_FAILED",
+		},
+		{
+			name:    "invalid email and username",
+			request: user("", "not an email"),
+			code:    codes.InvalidArgument,
+			reason:  "VALIDATION_FAILED",
+		},
+		{
+			name:    "localized",
+			header:  http.Header{"Accept-Language": {"fa"}},
+			request: user("", "not an email"),
+			code:    codes.InvalidArgument,
+			reason:  "VALIDATION_FAILED",
+		},
+		{
+			name:    "duplicate email",
+			request: user("newcomer", "taken@example.com"),
+			code:    codes.AlreadyExists,
+			reason:  "DUPLICATE_EMAIL",
+		},
+		{
+			name:    "duplicate username",
+			request: user("taken", "newcomer@example.com"),
+			code:    codes.AlreadyExists,
+			reason:  "DUPLICATE_USERNAME",
+		},
+		{
+			name: "request ID reused",
+			request: func(protocol string) *helloworldPb.CreateUserRequest {
+				return &helloworldPb.CreateUserRequest{
+					Username:  "second",
+					Email:     "second@example.com",
+					RequestId: "reused-" + protocol,
+				}
+			},
+			setup: func(ctx context.Context, s *server, protocol string) error {
+				_, err := s.service.CreateUser(ctx, &helloworldPb.CreateUserRequest{
+					Username:  "first" + strings.ReplaceAll(protocol, "-", ""),
+					Email:     "first@" + protocol + ".example.com",
+					RequestId: "reused-" + protocol,
+				})
+				return err
+			},
+			code:   codes.InvalidArgument,
+			reason: "REQUEST_ID_REUSED",
+		},
+		{
+			name:    "rate limited",
+			request: user("limited", "limited@example.com"),
+			setup: func(ctx context.Context, s *server, protocol string) error {
+				// the request itself gets a fresh bucket, so take its token
+				s.limiter.Allow("caller:"+callerID("rate limited", protocol), helloworldconnect.UserServiceCreateUserProcedure)
+				return nil
+			},
+			code:   codes.ResourceExhausted,
+			reason: helloworldPb.ErrorReason_RATE_LIMIT_EXCEEDED.String(),
+		},
+		{
+			name:    "internal error",
+			request: user(brokenUsername, "broken@example.com"),
+			code:    codes.Internal,
+			reason:  "INTERNAL_ERROR",
+		},
+	}
+}
+
+func check(ctx context.Context, srv *server, c errorCase, p protocol) error {
+	if c.setup != nil {
+		if err := c.setup(ctx, srv, p.name); err != nil {
+			return fmt.Errorf("setup failed: %w", err)
+		}
+	}
+
+	header := http.Header{callerHeader: {callerID(c.name, p.name)}}
+	for key, values := range c.header {
+		header[key] = values
+	}
+
+	err := p.createUser(ctx, header, c.request(p.name))
+	if err == nil {
+		return errors.New("call succeeded")
+	}
+	received, err := decodeStatus(err)
+	if err != nil {
+		return err
+	}
+
+	sent := srv.lastStatus()
+	if !proto.Equal(sent, received) {
+		return fmt.Errorf("sent %s\nreceived %s", protojson.Format(sent), protojson.Format(received))
+	}
+
+	if code := codes.Code(received.GetCode()); code != c.code {
+		return fmt.Errorf("got code %s, want %s", code, c.code)
+	}
+	var reason string
+	if info, ok := statusdetails.FromStatus(status.FromProto(received)).ErrorInfo(); ok {
+		reason = info.Reason
+	}
+	if reason != c.reason {
+		return fmt.Errorf("got reason %q, want %q", reason, c.reason)
+	}
+	return nil
+}
+
+func callerID(caseName, protocol string) string {
+	return strings.ReplaceAll(caseName, " ", "-") + "/" + protocol
+}
+
+// decodeStatus returns the status of a failed call the way the client saw it.
+func decodeStatus(err error) (*spb.Status, error) {
+	var connectErr *connect.Error
+	if !errors.As(err, &connectErr) {
+		st, ok := status.FromError(err)
+		if !ok {
+			return nil, fmt.Errorf("call failed without a status: %w", err)
+		}
+		return st.Proto(), nil
+	}
+
+	st := &spb.Status{
+		Code:    int32(connectErr.Code()),
+		Message: connectErr.Message(),
+	}
+	for _, detail := range connectErr.Details() {
+		st.Details = append(st.Details, &anypb.Any{
+			TypeUrl: "type.googleapis.com/" + detail.Type(),
+			Value:   detail.Bytes(),
+		})
+	}
+	return st, nil
+}
+
+type server struct {
+	service helloworldPb.UserServiceServer
+	limiter *ratelimit.Limiter
+
+	grpcServer    *grpc.Server
+	grpcConn      *grpc.ClientConn
+	connectServer *httptest.Server
+
+	mu   sync.Mutex
+	last *spb.Status
+}
+
+// newServer serves the user service the way the server command does, with
+// an interceptor recording the status of every failed call in front. gRPC is
+// served over bufconn and connect-go by an httptest server.
+func newServer(t *testing.T) *server {
+	t.Helper()
+
+	userRepo := brokenRepository{helloworld.NewInMemoryUserRepository()}
+	s := &server{
+		service: helloworld.NewUserService(userRepo, helloworld.NewInMemoryIdempotencyStore(time.Hour)),
+		limiter: ratelimit.NewLimiter(ratelimit.Config{
+			Default: &ratelimit.Limit{RequestsPerSecond: 1, Burst: 1},
+		}),
+	}
+
+	if _, err := s.service.CreateUser(context.Background(), &helloworldPb.CreateUserRequest{
+		Username: "taken",
+		Email:    "taken@example.com",
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	catalog, err := helloworld.LoadMessageCatalog("")
+	if err != nil {
+		t.Fatal(err)
+	}
+	errorInterceptor := interceptors.NewErrorInterceptor(
+		helloworldErrors.Registry(),
+		interceptors.WithDevMode(true),
+		interceptors.WithCatalog(catalog),
+	)
+	// every case is a caller of its own, which the test trusts to say so
+	rateLimitInterceptor := interceptors.NewRateLimitInterceptor(
+		s.limiter,
+		helloworldPb.ErrorReason_RATE_LIMIT_EXCEEDED.String(),
+		helloworldErrors.Registry().Domain(),
+		interceptors.WithCaller(func(ctx context.Context) (string, bool) {
+			md, _ := metadata.FromIncomingContext(ctx)
+			ids := md.Get(callerHeader)
+			return strings.Join(ids, ","), len(ids) > 0
+		}),
+	)
+	validationInterceptor := interceptors.NewValidationInterceptor(
+		helloworldErrors.ConstraintError,
+		interceptors.WithSkippedMethods(helloworldPb.UserService_CreateUserAlt_FullMethodName),
+	)
+	unaryInterceptors := []grpc.UnaryServerInterceptor{
+		s.record,
+		errorInterceptor.Unary(),
+		rateLimitInterceptor.Unary(),
+		validationInterceptor.Unary(),
+	}
+
+	s.grpcServer = grpc.NewServer(grpc.ChainUnaryInterceptor(unaryInterceptors...))
+	helloworldPb.RegisterUserServiceServer(s.grpcServer, s.service)
+	lis := bufconn.Listen(1 << 20)
+	go s.grpcServer.Serve(lis)
+	t.Cleanup(s.grpcServer.Stop)
+
+	s.grpcConn, err = grpc.NewClient("passthrough:///bufconn",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return lis.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { s.grpcConn.Close() })
+
+	path, handler := helloworldconnect.NewUserServiceHandler(
+		helloworld.NewConnectUserService(s.service),
+		connect.WithInterceptors(interceptors.NewConnectInterceptor(unaryInterceptors...)),
+	)
+	mux := http.NewServeMux()
+	mux.Handle(path, handler)
+	s.connectServer = httptest.NewServer(h2c.NewHandler(helloworld.WithCORS(mux, []string{pageOrigin}), &http2.Server{}))
+	t.Cleanup(s.connectServer.Close)
+
+	return s
+}
+
+func (s *server) protocols() []protocol {
+	grpcClient := helloworldPb.NewUserServiceClient(s.grpcConn)
+	h2cClient := &http.Client{
+		Transport: &http2.Transport{
+			AllowHTTP: true,
+			DialTLSContext: func(ctx context.Context, network, addr string, _ *tls.Config) (net.Conn, error) {
+				var d net.Dialer
+				return d.DialContext(ctx, network, addr)
+			},
+		},
+	}
+
+	return []protocol{
+		{"grpc", func(ctx context.Context, header http.Header, request *helloworldPb.CreateUserRequest) error {
+			md := metadata.MD{}
+			for key, values := range header {
+				md.Append(key, values...)
+			}
+			_, err := grpcClient.CreateUser(metadata.NewOutgoingContext(ctx, md), request)
+			return err
+		}},
+		{"connect-grpc", connectCreateUser(h2cClient, s.connectServer.URL, connect.WithGRPC())},
+		{"grpc-web", connectCreateUser(http.DefaultClient, s.connectServer.URL, connect.WithGRPCWeb())},
+		{"connect", connectCreateUser(http.DefaultClient, s.connectServer.URL)},
+		{"connect-json", connectCreateUser(http.DefaultClient, s.connectServer.URL, connect.WithProtoJSON())},
+	}
+}
+
+func connectCreateUser(httpClient connect.HTTPClient, url string, opts ...connect.ClientOption) createUserFunc {
+	client := helloworldconnect.NewUserServiceClient(httpClient, url, opts...)
+	return func(ctx context.Context, header http.Header, request *helloworldPb.CreateUserRequest) error {
+		req := connect.NewRequest(request)
+		for key, values := range header {
+			req.Header()[http.CanonicalHeaderKey(key)] = values
+		}
+		_, err := client.CreateUser(ctx, req)
+		return err
+	}
+}
+
+func (s *server) record(
+	ctx context.Context,
+	req any,
+	info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler,
+) (any, error) {
+	resp, err := handler(ctx, req)
+	if err != nil {
+		s.mu.Lock()
+		s.last = status.Convert(err).Proto()
+		s.mu.Unlock()
+	}
+	return resp, err
+}
+
+func (s *server) lastStatus() *spb.Status {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.last
+}
+
+type brokenRepository struct {
+	helloworld.UserRepository
+}
+
+func (r brokenRepository) AddUser(ctx context.Context, user helloworld.User) (helloworld.User, error) {
+	if user.Username == brokenUsername {
+		return helloworld.User{}, errors.New("storage is broken")
+	}
+	return r.UserRepository.AddUser(ctx, user)
+}
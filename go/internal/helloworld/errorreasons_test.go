@@ -0,0 +1,35 @@
+package helloworld
+
+import (
+	"testing"
+
+	helloworldPb "github.com/amirsalarsafaei/proto-error-handling/autogenerated/go/helloworld"
+
+	helloworldErrors "github.com/amirsalarsafaei/proto-error-handling/go/pkg/helloworld"
+)
+
+// TestErrorReasonsAreDeclared fails if the server can emit an ErrorInfo
+// reason, or has a localized message for one, that is not a value of the
+// ErrorReason enum in helloworld.proto.
+func TestErrorReasonsAreDeclared(t *testing.T) {
+	catalog, err := LoadMessageCatalog("")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	sources := []struct {
+		name    string
+		reasons []string
+	}{
+		{"error registry", helloworldErrors.Registry().Reasons()},
+		{"message catalog", catalog.Reasons()},
+	}
+	for _, source := range sources {
+		for _, reason := range source.reasons {
+			parsed, ok := helloworldErrors.ParseErrorReason(reason)
+			if !ok || parsed == helloworldPb.ErrorReason_ERROR_REASON_UNSPECIFIED {
+				t.Errorf("%s: %q is not an ErrorReason", source.name, reason)
+			}
+		}
+	}
+}
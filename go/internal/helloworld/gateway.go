@@ -0,0 +1,271 @@
+package helloworld
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"strings"
+
+	helloworldPb "github.com/amirsalarsafaei/proto-error-handling/autogenerated/go/helloworld"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	helloworldErrors "github.com/amirsalarsafaei/proto-error-handling/go/pkg/helloworld"
+	"github.com/amirsalarsafaei/proto-error-handling/go/pkg/problem"
+)
+
+const (
+	// grpcMetadataPrefix marks the request headers forwarded to the service
+	// as metadata, as grpc-gateway does.
+	grpcMetadataPrefix = "Grpc-Metadata-"
+	maxBodySize        = 1 << 20
+)
+
+type gateway struct {
+	client   helloworldPb.UserServiceClient
+	problems *problem.Writer
+}
+
+// NewGateway serves the UserService of client as an HTTP/JSON API. Failed
+// calls are answered with problem details written by problems, and so are
+// the in-band errors of CreateUserAlt, which the API doesn't tell apart from
+// the errors of CreateUser.
+func NewGateway(client helloworldPb.UserServiceClient, problems *problem.Writer) http.Handler {
+	g := &gateway{client: client, problems: problems}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /v1/users", g.createUser)
+	mux.HandleFunc("POST /v1/users:alt", g.createUserAlt)
+	mux.HandleFunc("GET /v1/users", g.listUsers)
+	mux.HandleFunc("GET /v1/users/{user_id}", g.getUser)
+	mux.HandleFunc("PATCH /v1/users/{user_id}", g.updateUser)
+	mux.HandleFunc("DELETE /v1/users/{user_id}", g.deleteUser)
+	return mux
+}
+
+func (g *gateway) createUser(w http.ResponseWriter, r *http.Request) {
+	request := &helloworldPb.CreateUserRequest{}
+	if _, ok := g.readBody(w, r, request); !ok {
+		return
+	}
+
+	response, err := g.client.CreateUser(outgoingContext(r), request)
+	if err != nil {
+		g.problems.Write(w, r, status.Convert(err))
+		return
+	}
+	w.Header().Set("Location", "/v1/users/"+response.GetUserId())
+	g.writeMessage(w, http.StatusCreated, response)
+}
+
+func (g *gateway) createUserAlt(w http.ResponseWriter, r *http.Request) {
+	request := &helloworldPb.CreateUserRequest{}
+	if _, ok := g.readBody(w, r, request); !ok {
+		return
+	}
+
+	response, err := g.client.CreateUserAlt(outgoingContext(r), request)
+	if err == nil {
+		err = helloworldErrors.AltError(response.GetError())
+	}
+	if err == nil && response.GetSuccess() == nil {
+		err = status.Error(codes.Internal, "CreateUserAlt returned neither a user nor an error")
+	}
+	if err != nil {
+		g.problems.Write(w, r, status.Convert(err))
+		return
+	}
+	w.Header().Set("Location", "/v1/users/"+response.GetSuccess().GetUserId())
+	g.writeMessage(w, http.StatusCreated, response.GetSuccess())
+}
+
+func (g *gateway) listUsers(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	request := &helloworldPb.ListUsersRequest{PageToken: query.Get("page_token")}
+
+	if raw := query.Get("status"); raw != "" {
+		value, ok := helloworldPb.UserStatus_value[raw]
+		if !ok {
+			g.problems.Write(w, r, status.Newf(codes.InvalidArgument, "invalid status %q", raw))
+			return
+		}
+		request.Status = helloworldPb.UserStatus(value)
+	}
+	if raw := query.Get("page_size"); raw != "" {
+		pageSize, err := strconv.ParseInt(raw, 10, 32)
+		if err != nil {
+			g.problems.Write(w, r, status.Newf(codes.InvalidArgument, "invalid page_size %q", raw))
+			return
+		}
+		request.PageSize = int32(pageSize)
+	}
+
+	response, err := g.client.ListUsers(outgoingContext(r), request)
+	if err != nil {
+		g.problems.Write(w, r, status.Convert(err))
+		return
+	}
+	g.writeMessage(w, http.StatusOK, response)
+}
+
+func (g *gateway) getUser(w http.ResponseWriter, r *http.Request) {
+	response, err := g.client.GetUser(outgoingContext(r), &helloworldPb.GetUserRequest{
+		UserId: r.PathValue("user_id"),
+	})
+	if err != nil {
+		g.problems.Write(w, r, status.Convert(err))
+		return
+	}
+	g.writeUser(w, response.GetUser(), response)
+}
+
+// updateUser applies the fields of the body listed in the update_mask query
+// parameter, or every field in the body if there is none. The etag can be
+// given in the body or as If-Match.
+func (g *gateway) updateUser(w http.ResponseWriter, r *http.Request) {
+	user := &helloworldPb.User{}
+	body, ok := g.readBody(w, r, user)
+	if !ok {
+		return
+	}
+	user.UserId = r.PathValue("user_id")
+	if user.GetEtag() == "" {
+		user.Etag = ifMatch(r)
+	}
+
+	var err error
+	mask := &fieldmaskpb.FieldMask{}
+	if raw := r.URL.Query().Get("update_mask"); raw != "" {
+		mask.Paths = strings.Split(raw, ",")
+	} else if mask.Paths, err = bodyFields(body, user); err != nil {
+		g.problems.Write(w, r, status.Newf(codes.InvalidArgument, "invalid request body: %v", err))
+		return
+	}
+
+	response, err := g.client.UpdateUser(outgoingContext(r), &helloworldPb.UpdateUserRequest{
+		User:       user,
+		UpdateMask: mask,
+	})
+	if err != nil {
+		g.problems.Write(w, r, status.Convert(err))
+		return
+	}
+	g.writeUser(w, response.GetUser(), response)
+}
+
+func (g *gateway) deleteUser(w http.ResponseWriter, r *http.Request) {
+	etag := r.URL.Query().Get("etag")
+	if etag == "" {
+		etag = ifMatch(r)
+	}
+
+	response, err := g.client.DeleteUser(outgoingContext(r), &helloworldPb.DeleteUserRequest{
+		UserId: r.PathValue("user_id"),
+		Etag:   etag,
+	})
+	if err != nil {
+		g.problems.Write(w, r, status.Convert(err))
+		return
+	}
+	g.writeMessage(w, http.StatusOK, response)
+}
+
+// bodyFields lists the fields of user set in body by their proto names, for
+// use as an update mask. The user_id and etag fields aren't updatable and are
+// left out.
+func bodyFields(body []byte, user *helloworldPb.User) ([]string, error) {
+	var members map[string]json.RawMessage
+	if err := json.Unmarshal(body, &members); err != nil {
+		return nil, err
+	}
+
+	fields := user.ProtoReflect().Descriptor().Fields()
+	var paths []string
+	for i := 0; i < fields.Len(); i++ {
+		field := fields.Get(i)
+		name := string(field.Name())
+		if name == "user_id" || name == "etag" {
+			continue
+		}
+		_, byName := members[name]
+		_, byJSONName := members[field.JSONName()]
+		if byName || byJSONName {
+			paths = append(paths, name)
+		}
+	}
+	return paths, nil
+}
+
+// ifMatch returns the etag of the If-Match header without its quotes.
+func ifMatch(r *http.Request) string {
+	etag := strings.TrimPrefix(r.Header.Get("If-Match"), "W/")
+	return strings.Trim(etag, `"`)
+}
+
+// outgoingContext forwards the Accept-Language and Grpc-Metadata-* headers
+// of r to the service.
+func outgoingContext(r *http.Request) context.Context {
+	md := metadata.MD{}
+	if lang := r.Header.Values("Accept-Language"); len(lang) > 0 {
+		md.Append("accept-language", lang...)
+	}
+	for key, values := range r.Header {
+		if name, ok := strings.CutPrefix(key, grpcMetadataPrefix); ok {
+			md.Append(name, values...)
+		}
+	}
+	return metadata.NewOutgoingContext(r.Context(), md)
+}
+
+// readBody decodes the body of r into message, answering with a problem if
+// it can't or if the body is larger than maxBodySize. It returns the raw body.
+func (g *gateway) readBody(w http.ResponseWriter, r *http.Request, message proto.Message) ([]byte, bool) {
+	body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxBodySize))
+	var tooLarge *http.MaxBytesError
+	if errors.As(err, &tooLarge) {
+		g.problems.WriteDetails(w, r, problem.Details{
+			Type:   "about:blank",
+			Title:  http.StatusText(http.StatusRequestEntityTooLarge),
+			Status: http.StatusRequestEntityTooLarge,
+			Detail: fmt.Sprintf("request body is larger than %d bytes", tooLarge.Limit),
+		})
+		return nil, false
+	}
+	if err != nil {
+		g.problems.Write(w, r, status.New(codes.InvalidArgument, "could not read request body"))
+		return nil, false
+	}
+	if err := protojson.Unmarshal(body, message); err != nil {
+		g.problems.Write(w, r, status.Newf(codes.InvalidArgument, "invalid request body: %v", err))
+		return nil, false
+	}
+	return body, true
+}
+
+// writeUser writes a response holding user, with the etag of user as ETag.
+func (g *gateway) writeUser(w http.ResponseWriter, user *helloworldPb.User, response proto.Message) {
+	w.Header().Set("ETag", strconv.Quote(user.GetEtag()))
+	g.writeMessage(w, http.StatusOK, response)
+}
+
+func (g *gateway) writeMessage(w http.ResponseWriter, httpStatus int, message proto.Message) {
+	body, err := protojson.Marshal(message)
+	if err != nil {
+		slog.Error("could not marshal response", slog.Any("error", err))
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(httpStatus)
+	w.Write(body)
+}
@@ -0,0 +1,77 @@
+package helloworld
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	helloworldPb "github.com/amirsalarsafaei/proto-error-handling/autogenerated/go/helloworld"
+	"google.golang.org/grpc"
+
+	"github.com/amirsalarsafaei/proto-error-handling/go/pkg/problem"
+)
+
+// altClient answers CreateUserAlt with response and fails every other call.
+type altClient struct {
+	helloworldPb.UserServiceClient
+	response *helloworldPb.CreateUserAltResponse
+}
+
+func (c altClient) CreateUserAlt(
+	ctx context.Context,
+	in *helloworldPb.CreateUserRequest,
+	opts ...grpc.CallOption,
+) (*helloworldPb.CreateUserAltResponse, error) {
+	return c.response, nil
+}
+
+func TestGatewayProblems(t *testing.T) {
+	success := &helloworldPb.CreateUserAltResponse{
+		Result: &helloworldPb.CreateUserAltResponse_Success{
+			Success: &helloworldPb.UserData{UserId: "1", Status: helloworldPb.UserStatus_USER_STATUS_PENDING},
+		},
+	}
+
+	tests := []struct {
+		name     string
+		response *helloworldPb.CreateUserAltResponse
+		body     string
+		want     int
+	}{
+		{"created", success, `{"username": "alice", "email": "alice@example.com"}`, http.StatusCreated},
+		{"body too large", success, `{"username": "` + strings.Repeat("a", maxBodySize) + `"}`, http.StatusRequestEntityTooLarge},
+		{"empty result", &helloworldPb.CreateUserAltResponse{}, `{"username": "alice", "email": "alice@example.com"}`, http.StatusInternalServerError},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gateway := NewGateway(altClient{response: tt.response}, problem.NewWriter())
+			request := httptest.NewRequest(http.MethodPost, "/v1/users:alt", strings.NewReader(tt.body))
+			recorder := httptest.NewRecorder()
+			gateway.ServeHTTP(recorder, request)
+
+			if recorder.Code != tt.want {
+				t.Fatalf("got status %d, want %d: %s", recorder.Code, tt.want, recorder.Body)
+			}
+			if tt.want == http.StatusCreated {
+				return
+			}
+
+			if contentType := recorder.Header().Get("Content-Type"); contentType != problem.ContentType {
+				t.Errorf("got Content-Type %q, want %q", contentType, problem.ContentType)
+			}
+			var details problem.Details
+			if err := json.Unmarshal(recorder.Body.Bytes(), &details); err != nil {
+				t.Fatal(err)
+			}
+			if details.Status != tt.want {
+				t.Errorf("got problem status %d, want %d", details.Status, tt.want)
+			}
+			if location := recorder.Header().Get("Location"); location != "" {
+				t.Errorf("got Location %q for a failed request", location)
+			}
+		})
+	}
+}
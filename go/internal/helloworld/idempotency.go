@@ -0,0 +1,319 @@
+package helloworld
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+
+	helloworldErrors "github.com/amirsalarsafaei/proto-error-handling/go/pkg/helloworld"
+)
+
+// IdempotencyRecord is the response a request was answered with, kept so the
+// request can be replayed under the same request ID.
+type IdempotencyRecord struct {
+	Key         string
+	Fingerprint []byte
+	Response    []byte
+	CreatedAt   time.Time
+}
+
+// IdempotencyStore keeps IdempotencyRecords for a fixed lifetime. Expired
+// records are treated as missing.
+type IdempotencyStore interface {
+	Get(ctx context.Context, key string) (IdempotencyRecord, bool, error)
+	// Put stores record unless there is already a live record for its key.
+	Put(ctx context.Context, record IdempotencyRecord) error
+}
+
+type idempotentRequest interface {
+	proto.Message
+	GetRequestId() string
+}
+
+// idempotent handles request with handle at most once per request ID, so a
+// client retrying a request it didn't get the response to receives the
+// response of the first attempt. A retry that arrives while the first attempt
+// is still running waits for it. Failed requests aren't stored and may be
+// retried. Requests without an ID are always handled.
+func idempotent[T proto.Message](
+	ctx context.Context,
+	store IdempotencyStore,
+	inFlight *requestLocks,
+	method string,
+	request idempotentRequest,
+	handle func() (T, error),
+) (T, error) {
+	var zero T
+	if request.GetRequestId() == "" {
+		return handle()
+	}
+
+	key := method + "/" + request.GetRequestId()
+	hash, err := fingerprint(request)
+	if err != nil {
+		return zero, err
+	}
+
+	unlock, err := inFlight.lock(ctx, key)
+	if err != nil {
+		return zero, err
+	}
+	defer unlock()
+
+	response := zero.ProtoReflect().New().Interface().(T)
+	replayed, err := replay(ctx, store, key, hash, response)
+	switch {
+	case errors.Is(err, helloworldErrors.ErrRequestIDReused):
+		return zero, helloworldErrors.NewRequestIDReusedError(request.GetRequestId())
+	case err != nil:
+		return zero, err
+	case replayed:
+		return response, nil
+	}
+
+	if response, err = handle(); err != nil {
+		return response, err
+	}
+
+	// the request succeeded either way, so failing it now would only make
+	// the client retry into a conflict
+	if err := remember(ctx, store, key, hash, response); err != nil {
+		slog.Warn("could not store response for replays",
+			"method", method,
+			"error", err)
+	}
+	return response, nil
+}
+
+// requestLocks holds a lock per request key, so only one attempt of a request
+// is handled at a time.
+type requestLocks struct {
+	locks map[string]*requestLock
+	mu    sync.Mutex
+}
+
+type requestLock struct {
+	// held has room for one token, taken by the attempt being handled
+	held chan struct{}
+	// refs counts the attempts holding or waiting for the lock, which is
+	// dropped with the last of them
+	refs int
+}
+
+func newRequestLocks() *requestLocks {
+	return &requestLocks{locks: make(map[string]*requestLock)}
+}
+
+// lock waits until no other attempt holds the lock of key, or ctx is done,
+// and returns the function that releases it.
+func (l *requestLocks) lock(ctx context.Context, key string) (func(), error) {
+	l.mu.Lock()
+	lock, ok := l.locks[key]
+	if !ok {
+		lock = &requestLock{held: make(chan struct{}, 1)}
+		l.locks[key] = lock
+	}
+	lock.refs++
+	l.mu.Unlock()
+
+	release := func() {
+		l.mu.Lock()
+		defer l.mu.Unlock()
+		if lock.refs--; lock.refs == 0 {
+			delete(l.locks, key)
+		}
+	}
+
+	select {
+	case lock.held <- struct{}{}:
+		return func() {
+			<-lock.held
+			release()
+		}, nil
+	case <-ctx.Done():
+		release()
+		return nil, ctx.Err()
+	}
+}
+
+// fingerprint hashes request without its request_id field, so replays can be
+// told apart from different requests that reuse the ID.
+func fingerprint(request proto.Message) ([]byte, error) {
+	request = proto.Clone(request)
+	msg := request.ProtoReflect()
+	if field := msg.Descriptor().Fields().ByName("request_id"); field != nil {
+		msg.Clear(field)
+	}
+
+	data, err := proto.MarshalOptions{Deterministic: true}.Marshal(request)
+	if err != nil {
+		return nil, fmt.Errorf("could not marshal request: %w", err)
+	}
+	sum := sha256.Sum256(data)
+	return sum[:], nil
+}
+
+// replay looks the request up under its request ID and, if it was already
+// answered, unmarshals the original response into response. It returns
+// helloworldErrors.ErrRequestIDReused if the ID was used for another request.
+func replay(
+	ctx context.Context,
+	store IdempotencyStore,
+	key string,
+	fingerprint []byte,
+	response proto.Message,
+) (bool, error) {
+	record, ok, err := store.Get(ctx, key)
+	if err != nil || !ok {
+		return false, err
+	}
+
+	if !bytes.Equal(record.Fingerprint, fingerprint) {
+		return false, helloworldErrors.ErrRequestIDReused
+	}
+	if err := proto.Unmarshal(record.Response, response); err != nil {
+		return false, fmt.Errorf("could not unmarshal stored response: %w", err)
+	}
+	return true, nil
+}
+
+func remember(
+	ctx context.Context,
+	store IdempotencyStore,
+	key string,
+	fingerprint []byte,
+	response proto.Message,
+) error {
+	data, err := proto.Marshal(response)
+	if err != nil {
+		return fmt.Errorf("could not marshal response: %w", err)
+	}
+
+	return store.Put(ctx, IdempotencyRecord{
+		Key:         key,
+		Fingerprint: fingerprint,
+		Response:    data,
+		CreatedAt:   time.Now(),
+	})
+}
+
+type inMemoryIdempotencyStore struct {
+	ttl     time.Duration
+	records map[string]IdempotencyRecord
+	// order holds the records in the order they were stored, which with a
+	// fixed lifetime is also the order they expire in
+	order []IdempotencyRecord
+	mu    sync.Mutex
+}
+
+func NewInMemoryIdempotencyStore(ttl time.Duration) IdempotencyStore {
+	return &inMemoryIdempotencyStore{
+		ttl:     ttl,
+		records: make(map[string]IdempotencyRecord),
+	}
+}
+
+func (s *inMemoryIdempotencyStore) Get(ctx context.Context, key string) (IdempotencyRecord, bool, error) {
+	if err := ctx.Err(); err != nil {
+		return IdempotencyRecord{}, false, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.expire(time.Now())
+	record, ok := s.records[key]
+	return record, ok, nil
+}
+
+func (s *inMemoryIdempotencyStore) Put(ctx context.Context, record IdempotencyRecord) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.expire(time.Now())
+	if _, ok := s.records[record.Key]; ok {
+		return nil
+	}
+
+	s.records[record.Key] = record
+	s.order = append(s.order, record)
+	return nil
+}
+
+func (s *inMemoryIdempotencyStore) expire(now time.Time) {
+	var expired int
+	for _, record := range s.order {
+		if now.Sub(record.CreatedAt) <= s.ttl {
+			break
+		}
+		delete(s.records, record.Key)
+		expired++
+	}
+	s.order = s.order[expired:]
+}
+
+type sqlIdempotencyStore struct {
+	db  *sql.DB
+	ttl time.Duration
+}
+
+// NewSQLIdempotencyStore returns an IdempotencyStore backed by db, which must
+// already be migrated with Migrate.
+func NewSQLIdempotencyStore(db *sql.DB, ttl time.Duration) IdempotencyStore {
+	return &sqlIdempotencyStore{db: db, ttl: ttl}
+}
+
+func (s *sqlIdempotencyStore) Get(ctx context.Context, key string) (IdempotencyRecord, bool, error) {
+	record := IdempotencyRecord{Key: key}
+	var createdAt int64
+
+	err := s.db.QueryRowContext(ctx,
+		`SELECT fingerprint, response, created_at FROM idempotency_keys
+		WHERE key = ? AND created_at > ?`,
+		key, s.expiredBefore(),
+	).Scan(&record.Fingerprint, &record.Response, &createdAt)
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+		return IdempotencyRecord{}, false, nil
+	case err != nil:
+		return IdempotencyRecord{}, false, fmt.Errorf("could not read idempotency record: %w", err)
+	}
+
+	record.CreatedAt = time.Unix(0, createdAt)
+	return record, true, nil
+}
+
+func (s *sqlIdempotencyStore) Put(ctx context.Context, record IdempotencyRecord) error {
+	_, err := s.db.ExecContext(ctx,
+		`DELETE FROM idempotency_keys WHERE created_at <= ?`, s.expiredBefore(),
+	)
+	if err != nil {
+		return fmt.Errorf("could not delete expired idempotency records: %w", err)
+	}
+
+	_, err = s.db.ExecContext(ctx,
+		`INSERT INTO idempotency_keys (key, fingerprint, response, created_at)
+		VALUES (?, ?, ?, ?) ON CONFLICT (key) DO NOTHING`,
+		record.Key, record.Fingerprint, record.Response, record.CreatedAt.UnixNano(),
+	)
+	if err != nil {
+		return fmt.Errorf("could not store idempotency record: %w", err)
+	}
+	return nil
+}
+
+func (s *sqlIdempotencyStore) expiredBefore() int64 {
+	return time.Now().Add(-s.ttl).UnixNano()
+}
@@ -0,0 +1,129 @@
+package helloworld
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+
+	helloworldPb "github.com/amirsalarsafaei/proto-error-handling/autogenerated/go/helloworld"
+
+	helloworldErrors "github.com/amirsalarsafaei/proto-error-handling/go/pkg/helloworld"
+)
+
+func TestIdempotentRetryWaitsForFirstAttempt(t *testing.T) {
+	ctx := context.Background()
+	store := NewInMemoryIdempotencyStore(time.Minute)
+	inFlight := newRequestLocks()
+	request := &helloworldPb.CreateUserRequest{Username: "alice", Email: "alice@example.com", RequestId: "1"}
+
+	var handled atomic.Int32
+	started, finish := make(chan struct{}), make(chan struct{})
+	handle := func() (*helloworldPb.CreateUserResponse, error) {
+		if handled.Add(1) == 1 {
+			close(started)
+			<-finish
+		}
+		return &helloworldPb.CreateUserResponse{UserId: "first"}, nil
+	}
+
+	type result struct {
+		response *helloworldPb.CreateUserResponse
+		err      error
+	}
+	results := make(chan result, 2)
+	call := func() {
+		response, err := idempotent(ctx, store, inFlight, "CreateUser", request, handle)
+		results <- result{response, err}
+	}
+
+	go call()
+	<-started
+	go call()
+	// let the retry queue up behind the first attempt before finishing it
+	for waiting := 0; waiting < 2; {
+		inFlight.mu.Lock()
+		if lock, ok := inFlight.locks["CreateUser/1"]; ok {
+			waiting = lock.refs
+		}
+		inFlight.mu.Unlock()
+	}
+	close(finish)
+
+	for i := 0; i < 2; i++ {
+		r := <-results
+		if r.err != nil {
+			t.Fatal(r.err)
+		}
+		if r.response.GetUserId() != "first" {
+			t.Errorf("got user %q, want the response of the first attempt", r.response.GetUserId())
+		}
+	}
+	if n := handled.Load(); n != 1 {
+		t.Errorf("handled %d times, want 1", n)
+	}
+	if len(inFlight.locks) != 0 {
+		t.Errorf("%d locks left after every attempt returned", len(inFlight.locks))
+	}
+}
+
+func TestIdempotentFailuresAreRetried(t *testing.T) {
+	ctx := context.Background()
+	store := NewInMemoryIdempotencyStore(time.Minute)
+	inFlight := newRequestLocks()
+	request := &helloworldPb.CreateUserRequest{Username: "alice", Email: "alice@example.com", RequestId: "1"}
+
+	failure := errors.New("unavailable")
+	responses := []struct {
+		response *helloworldPb.CreateUserResponse
+		err      error
+	}{
+		{nil, failure},
+		{&helloworldPb.CreateUserResponse{UserId: "second"}, nil},
+		{&helloworldPb.CreateUserResponse{UserId: "third"}, nil},
+	}
+	var handled int
+	handle := func() (*helloworldPb.CreateUserResponse, error) {
+		r := responses[handled]
+		handled++
+		return r.response, r.err
+	}
+
+	if _, err := idempotent(ctx, store, inFlight, "CreateUser", request, handle); !errors.Is(err, failure) {
+		t.Fatalf("got %v, want %v", err, failure)
+	}
+	for i := 0; i < 2; i++ {
+		response, err := idempotent(ctx, store, inFlight, "CreateUser", request, handle)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if response.GetUserId() != "second" {
+			t.Errorf("attempt %d: got user %q, want second", i+2, response.GetUserId())
+		}
+	}
+	if handled != 2 {
+		t.Errorf("handled %d times, want 2", handled)
+	}
+}
+
+func TestIdempotentRequestIDReused(t *testing.T) {
+	ctx := context.Background()
+	store := NewInMemoryIdempotencyStore(time.Minute)
+	inFlight := newRequestLocks()
+	request := &helloworldPb.CreateUserRequest{Username: "alice", Email: "alice@example.com", RequestId: "1"}
+	handle := func() (*helloworldPb.CreateUserResponse, error) {
+		return &helloworldPb.CreateUserResponse{UserId: "first"}, nil
+	}
+
+	if _, err := idempotent(ctx, store, inFlight, "CreateUser", request, handle); err != nil {
+		t.Fatal(err)
+	}
+	other := proto.Clone(request).(*helloworldPb.CreateUserRequest)
+	other.Username = "bob"
+	if _, err := idempotent(ctx, store, inFlight, "CreateUser", other, handle); !errors.Is(err, helloworldErrors.ErrRequestIDReused) {
+		t.Errorf("got %v, want ErrRequestIDReused", err)
+	}
+}
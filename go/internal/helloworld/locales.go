@@ -0,0 +1,31 @@
+package helloworld
+
+import (
+	"embed"
+	"io/fs"
+	"os"
+
+	"github.com/amirsalarsafaei/proto-error-handling/go/pkg/localization"
+)
+
+const defaultLocale = "en"
+
+//go:embed locales/*.json
+var embeddedLocales embed.FS
+
+// LoadMessageCatalog loads the error message catalog from dir, or the
+// catalog embedded in the binary when dir is empty.
+func LoadMessageCatalog(dir string) (*localization.Catalog, error) {
+	var locales fs.FS
+	if dir != "" {
+		locales = os.DirFS(dir)
+	} else {
+		var err error
+		locales, err = fs.Sub(embeddedLocales, "locales")
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return localization.LoadCatalog(locales, defaultLocale)
+}
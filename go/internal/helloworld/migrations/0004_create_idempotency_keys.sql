@@ -0,0 +1,8 @@
+CREATE TABLE idempotency_keys (
+    key         TEXT    PRIMARY KEY,
+    fingerprint BLOB    NOT NULL,
+    response    BLOB    NOT NULL,
+    created_at  INTEGER NOT NULL
+);
+
+CREATE INDEX idempotency_keys_created_at ON idempotency_keys (created_at);
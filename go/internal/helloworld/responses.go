@@ -0,0 +1,61 @@
+package helloworld
+
+import (
+	helloworldPb "github.com/amirsalarsafaei/proto-error-handling/autogenerated/go/helloworld"
+
+	helloworldErrors "github.com/amirsalarsafaei/proto-error-handling/go/pkg/helloworld"
+)
+
+// statusResponse builds the response of an RPC that reports errors as status
+// errors: success(value), or err for the error interceptor to convert.
+func statusResponse[T, R any](value T, err error, success func(T) R) (R, error) {
+	if err != nil {
+		var zero R
+		return zero, err
+	}
+	return success(value), nil
+}
+
+// altResponse builds the response of an RPC that reports errors in-band:
+// success(value), or failure with the ErrorDetails of err. Errors the registry
+// doesn't know aren't part of the API and fail the call with a status, as do
+// context errors, since the caller has given up and no one would read them.
+func altResponse[T, R any](
+	value T,
+	err error,
+	success func(T) R,
+	failure func(*helloworldPb.ErrorDetails) R,
+) (R, error) {
+	if err == nil {
+		return success(value), nil
+	}
+
+	var zero R
+	if _, known := helloworldErrors.Registry().Lookup(err); !known || isContextError(err) {
+		return zero, err
+	}
+	return failure(errorDetails(err)), nil
+}
+
+func errorDetails(err error) *helloworldPb.ErrorDetails {
+	resolved := helloworldErrors.Registry().Resolve(err)
+
+	violations := make([]*helloworldPb.FieldViolation, 0, len(resolved.Violations))
+	for _, v := range resolved.Violations {
+		// reasons outside the enum are caught by TestErrorReasonsAreDeclared
+		// and would be reported as ERROR_REASON_UNSPECIFIED
+		code, _ := helloworldErrors.ParseErrorReason(v.Reason)
+		violations = append(violations, &helloworldPb.FieldViolation{
+			Field:   v.Field,
+			Code:    code,
+			Message: v.Description,
+		})
+	}
+
+	code, _ := helloworldErrors.ParseErrorReason(resolved.Reason)
+	return &helloworldPb.ErrorDetails{
+		Code:            code,
+		Message:         resolved.Message,
+		FieldViolations: violations,
+	}
+}
@@ -0,0 +1,214 @@
+package helloworld
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"modernc.org/sqlite"
+	sqlite3 "modernc.org/sqlite/lib"
+
+	helloworldErrors "github.com/amirsalarsafaei/proto-error-handling/go/pkg/helloworld"
+)
+
+type sqlUserRepository struct {
+	db *sql.DB
+}
+
+// NewSQLUserRepository returns a UserRepository backed by db, which must
+// already be migrated with Migrate.
+func NewSQLUserRepository(db *sql.DB) UserRepository {
+	return &sqlUserRepository{db: db}
+}
+
+func (r *sqlUserRepository) AddUser(ctx context.Context, user User) (User, error) {
+	user.UUID = uuid.New()
+	user.Version = 1
+
+	_, err := r.db.ExecContext(ctx,
+		`INSERT INTO users (id, username, email, status, version) VALUES (?, ?, ?, ?, ?)`,
+		user.UUID.String(), user.Username, user.Email, user.Status, user.Version,
+	)
+	if err != nil {
+		if dupErr := uniqueViolation(err, user); dupErr != nil {
+			return user, dupErr
+		}
+		return user, fmt.Errorf("could not add user: %w", err)
+	}
+	return user, nil
+}
+
+func (r *sqlUserRepository) GetUserByID(ctx context.Context, id uuid.UUID) (User, error) {
+	user, err := r.getUser(ctx, "id", id.String())
+	if errors.Is(err, sql.ErrNoRows) {
+		return User{}, userNotFoundError("id", id.String())
+	}
+	return user, err
+}
+
+func (r *sqlUserRepository) GetUserByEmail(ctx context.Context, email string) (User, error) {
+	user, err := r.getUser(ctx, "email", email)
+	if errors.Is(err, sql.ErrNoRows) {
+		return User{}, userNotFoundError("email", email)
+	}
+	return user, err
+}
+
+func (r *sqlUserRepository) GetUserByUsername(ctx context.Context, username string) (User, error) {
+	user, err := r.getUser(ctx, "username", username)
+	if errors.Is(err, sql.ErrNoRows) {
+		return User{}, userNotFoundError("username", username)
+	}
+	return user, err
+}
+
+func (r *sqlUserRepository) ListUsers(ctx context.Context, query ListUsersQuery) (UserPage, error) {
+	// fetch one more user than asked for to learn whether there's a next page
+	rows, err := r.db.QueryContext(ctx,
+		`SELECT seq, id, username, email, status, version FROM users
+		WHERE seq > ? AND (? = '' OR status = ?)
+		ORDER BY seq LIMIT ?`,
+		query.After, query.Status, query.Status, query.Limit+1,
+	)
+	if err != nil {
+		return UserPage{}, fmt.Errorf("could not list users: %w", err)
+	}
+	defer rows.Close()
+
+	var (
+		page UserPage
+		last UserCursor
+	)
+	for rows.Next() {
+		if len(page.Users) == query.Limit {
+			page.Next = last
+			break
+		}
+
+		var seq UserCursor
+		user, err := scanUser(rows, &seq)
+		if err != nil {
+			return UserPage{}, err
+		}
+		page.Users = append(page.Users, user)
+		last = seq
+	}
+	if err := rows.Err(); err != nil {
+		return UserPage{}, fmt.Errorf("could not list users: %w", err)
+	}
+	return page, nil
+}
+
+func (r *sqlUserRepository) UpdateUser(ctx context.Context, user User) (User, error) {
+	result, err := r.db.ExecContext(ctx,
+		`UPDATE users SET username = ?, email = ?, status = ?, version = version + 1
+		WHERE id = ? AND version = ?`,
+		user.Username, user.Email, user.Status, user.UUID.String(), user.Version,
+	)
+	if err != nil {
+		if dupErr := uniqueViolation(err, user); dupErr != nil {
+			return user, dupErr
+		}
+		return user, fmt.Errorf("could not update user: %w", err)
+	}
+
+	if err := r.expectAffected(ctx, result, user.UUID, user.Version); err != nil {
+		return user, err
+	}
+
+	user.Version++
+	return user, nil
+}
+
+func (r *sqlUserRepository) DeleteUser(ctx context.Context, id uuid.UUID, version int64) error {
+	result, err := r.db.ExecContext(ctx,
+		`DELETE FROM users WHERE id = ? AND version = ?`, id.String(), version,
+	)
+	if err != nil {
+		return fmt.Errorf("could not delete user: %w", err)
+	}
+	return r.expectAffected(ctx, result, id, version)
+}
+
+// expectAffected explains why a statement conditioned on the id and version
+// of a user changed no rows: either the user is gone or it is at another
+// version.
+func (r *sqlUserRepository) expectAffected(
+	ctx context.Context,
+	result sql.Result,
+	id uuid.UUID,
+	version int64,
+) error {
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("could not count affected users: %w", err)
+	}
+	if affected > 0 {
+		return nil
+	}
+
+	var current int64
+	err = r.db.QueryRowContext(ctx, `SELECT version FROM users WHERE id = ?`, id.String()).Scan(&current)
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+		return userNotFoundError("id", id.String())
+	case err != nil:
+		return fmt.Errorf("could not read user version: %w", err)
+	default:
+		return versionConflictError(id, version, current)
+	}
+}
+
+// getUser looks a user up by column, which must be one of the unique columns
+// of the users table.
+func (r *sqlUserRepository) getUser(ctx context.Context, column, value string) (User, error) {
+	row := r.db.QueryRowContext(ctx,
+		`SELECT id, username, email, status, version FROM users WHERE `+column+` = ?`, value,
+	)
+	return scanUser(row)
+}
+
+// scanUser reads the id, username, email, status and version columns of row,
+// after any leading columns scanned into dest.
+func scanUser(row interface{ Scan(dest ...any) error }, dest ...any) (User, error) {
+	var (
+		user User
+		id   string
+	)
+	dest = append(dest, &id, &user.Username, &user.Email, &user.Status, &user.Version)
+	if err := row.Scan(dest...); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return User{}, err
+		}
+		return User{}, fmt.Errorf("could not read user: %w", err)
+	}
+
+	var err error
+	if user.UUID, err = uuid.Parse(id); err != nil {
+		return User{}, fmt.Errorf("invalid id %q for user %s: %w", id, user.Username, err)
+	}
+	return user, nil
+}
+
+// uniqueViolation translates a unique constraint violation on users into the
+// same error the in-memory repository returns, and returns nil for any other
+// error. SQLite names the violated columns rather than the constraint, e.g.
+// "UNIQUE constraint failed: users.email".
+func uniqueViolation(err error, user User) error {
+	var sqliteErr *sqlite.Error
+	if !errors.As(err, &sqliteErr) || sqliteErr.Code() != sqlite3.SQLITE_CONSTRAINT_UNIQUE {
+		return nil
+	}
+
+	switch msg := sqliteErr.Error(); {
+	case strings.Contains(msg, "users.email"):
+		return helloworldErrors.NewDuplicateEmailError(user.Email)
+	case strings.Contains(msg, "users.username"):
+		return helloworldErrors.NewDuplicateUsernameError(user.Username)
+	default:
+		return nil
+	}
+}
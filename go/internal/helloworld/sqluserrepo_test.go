@@ -0,0 +1,225 @@
+package helloworld
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+
+	helloworldErrors "github.com/amirsalarsafaei/proto-error-handling/go/pkg/helloworld"
+)
+
+func openTestDatabase(t *testing.T) *sql.DB {
+	t.Helper()
+	db, err := sql.Open("sqlite", ":memory:")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { db.Close() })
+	// every connection to :memory: opens a database of its own
+	db.SetMaxOpenConns(1)
+
+	if err := Migrate(context.Background(), db); err != nil {
+		t.Fatal(err)
+	}
+	return db
+}
+
+func TestMigrateIsIdempotent(t *testing.T) {
+	ctx := context.Background()
+	db := openTestDatabase(t)
+	repo := NewSQLUserRepository(db)
+	user, err := repo.AddUser(ctx, User{Username: "alice", Email: "alice@example.com", Status: UserStatusActive})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := Migrate(ctx, db); err != nil {
+		t.Fatalf("second Migrate: %v", err)
+	}
+
+	var applied int
+	if err := db.QueryRowContext(ctx, `SELECT COUNT(*) FROM schema_migrations`).Scan(&applied); err != nil {
+		t.Fatal(err)
+	}
+	files, err := migrations.ReadDir("migrations")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if applied != len(files) {
+		t.Errorf("%d migrations recorded, want %d", applied, len(files))
+	}
+	if _, err := repo.GetUserByID(ctx, user.UUID); err != nil {
+		t.Errorf("user lost after second Migrate: %v", err)
+	}
+}
+
+func TestSQLUserRepositoryDuplicates(t *testing.T) {
+	ctx := context.Background()
+	repo := NewSQLUserRepository(openTestDatabase(t))
+	if _, err := repo.AddUser(ctx, User{Username: "alice", Email: "alice@example.com"}); err != nil {
+		t.Fatal(err)
+	}
+	bob, err := repo.AddUser(ctx, User{Username: "bob", Email: "bob@example.com"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name  string
+		write func() error
+		want  error
+	}{
+		{"add email", func() error {
+			_, err := repo.AddUser(ctx, User{Username: "carol", Email: "alice@example.com"})
+			return err
+		}, helloworldErrors.ErrDuplicateEmail},
+		{"add username", func() error {
+			_, err := repo.AddUser(ctx, User{Username: "alice", Email: "carol@example.com"})
+			return err
+		}, helloworldErrors.ErrDuplicateUsername},
+		{"update email", func() error {
+			user := bob
+			user.Email = "alice@example.com"
+			_, err := repo.UpdateUser(ctx, user)
+			return err
+		}, helloworldErrors.ErrDuplicateEmail},
+		{"update username", func() error {
+			user := bob
+			user.Username = "alice"
+			_, err := repo.UpdateUser(ctx, user)
+			return err
+		}, helloworldErrors.ErrDuplicateUsername},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.write(); !errors.Is(err, tt.want) {
+				t.Errorf("got %v, want %v", err, tt.want)
+			}
+		})
+	}
+}
+
+// uniqueViolation tells the columns apart by the message of the driver, so
+// this pins the messages it relies on.
+func TestUniqueViolationMessages(t *testing.T) {
+	ctx := context.Background()
+	db := openTestDatabase(t)
+	insert := `INSERT INTO users (id, username, email) VALUES (?, ?, ?)`
+	if _, err := db.ExecContext(ctx, insert, uuid.NewString(), "alice", "alice@example.com"); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		username, email string
+		message         string
+		want            error
+	}{
+		{"bob", "alice@example.com", "UNIQUE constraint failed: users.email", helloworldErrors.ErrDuplicateEmail},
+		{"alice", "bob@example.com", "UNIQUE constraint failed: users.username", helloworldErrors.ErrDuplicateUsername},
+	}
+	for _, tt := range tests {
+		_, err := db.ExecContext(ctx, insert, uuid.NewString(), tt.username, tt.email)
+		if err == nil || !strings.Contains(err.Error(), tt.message) {
+			t.Errorf("inserting %s <%s>: got %v, want %q", tt.username, tt.email, err, tt.message)
+		}
+		if dupErr := uniqueViolation(err, User{Username: tt.username, Email: tt.email}); !errors.Is(dupErr, tt.want) {
+			t.Errorf("inserting %s <%s>: got %v, want %v", tt.username, tt.email, dupErr, tt.want)
+		}
+	}
+
+	if err := uniqueViolation(errors.New("UNIQUE constraint failed: users.email"), User{}); err != nil {
+		t.Errorf("got %v for an error not from the driver, want nil", err)
+	}
+}
+
+func TestSQLUserRepositoryVersionConflicts(t *testing.T) {
+	ctx := context.Background()
+	repo := NewSQLUserRepository(openTestDatabase(t))
+	user, err := repo.AddUser(ctx, User{Username: "alice", Email: "alice@example.com"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	stale := user
+	if user, err = repo.UpdateUser(ctx, user); err != nil {
+		t.Fatal(err)
+	}
+	if user.Version != 2 {
+		t.Fatalf("version %d after update, want 2", user.Version)
+	}
+
+	var conflict *VersionConflictError
+	if _, err := repo.UpdateUser(ctx, stale); !errors.As(err, &conflict) {
+		t.Fatalf("stale update: got %v, want a VersionConflictError", err)
+	}
+	if conflict.Expected != 1 || conflict.Current != 2 {
+		t.Errorf("stale update: expected %d, current %d, want 1 and 2", conflict.Expected, conflict.Current)
+	}
+	if err := repo.DeleteUser(ctx, user.UUID, stale.Version); !errors.Is(err, helloworldErrors.ErrVersionConflict) {
+		t.Errorf("stale delete: got %v, want ErrVersionConflict", err)
+	}
+
+	if err := repo.DeleteUser(ctx, user.UUID, user.Version); err != nil {
+		t.Fatal(err)
+	}
+	if err := repo.DeleteUser(ctx, user.UUID, user.Version); !errors.Is(err, helloworldErrors.ErrUserNotFound) {
+		t.Errorf("delete of deleted user: got %v, want ErrUserNotFound", err)
+	}
+	if _, err := repo.UpdateUser(ctx, user); !errors.Is(err, helloworldErrors.ErrUserNotFound) {
+		t.Errorf("update of deleted user: got %v, want ErrUserNotFound", err)
+	}
+}
+
+func TestSQLUserRepositoryListUsers(t *testing.T) {
+	ctx := context.Background()
+	repo := NewSQLUserRepository(openTestDatabase(t))
+	for i := 0; i < 5; i++ {
+		status := UserStatusPending
+		if i%2 == 0 {
+			status = UserStatusActive
+		}
+		user := User{Username: fmt.Sprintf("user-%d", i), Email: fmt.Sprintf("user-%d@example.com", i), Status: status}
+		if _, err := repo.AddUser(ctx, user); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	tests := []struct {
+		name   string
+		status UserStatus
+		limit  int
+		want   [][]string
+	}{
+		{"pages", "", 2, [][]string{{"user-0", "user-1"}, {"user-2", "user-3"}, {"user-4"}}},
+		{"exact last page", "", 5, [][]string{{"user-0", "user-1", "user-2", "user-3", "user-4"}}},
+		{"status", UserStatusActive, 2, [][]string{{"user-0", "user-2"}, {"user-4"}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			query := ListUsersQuery{Status: tt.status, Limit: tt.limit}
+			for i, want := range tt.want {
+				page, err := repo.ListUsers(ctx, query)
+				if err != nil {
+					t.Fatal(err)
+				}
+				var got []string
+				for _, user := range page.Users {
+					got = append(got, user.Username)
+				}
+				if fmt.Sprint(got) != fmt.Sprint(want) {
+					t.Errorf("page %d: got %v, want %v", i+1, got, want)
+				}
+
+				last := i == len(tt.want)-1
+				if (page.Next == 0) != last {
+					t.Fatalf("page %d: next cursor %d, last page %t", i+1, page.Next, last)
+				}
+				query.After = page.Next
+			}
+		})
+	}
+}
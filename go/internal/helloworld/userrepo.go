@@ -1,121 +1,287 @@
 package helloworld
 
 import (
-	"errors"
+	"context"
 	"fmt"
-	"net/mail"
+	"slices"
+	"sort"
+	"strconv"
 	"sync"
 
 	"github.com/google/uuid"
-)
 
-const (
-	maxUsernameLength = 50
+	"github.com/amirsalarsafaei/proto-error-handling/go/pkg/apperrors"
+	helloworldErrors "github.com/amirsalarsafaei/proto-error-handling/go/pkg/helloworld"
 )
 
-var (
-	ErrUserNotFound      = errors.New("user not found")
-	ErrDuplicateEmail    = errors.New("user with email already exists")
-	ErrDuplicateUsername = errors.New("user with username already exists")
-	ErrEmptyUsername     = errors.New("username cannot be empty")
-	ErrEmptyEmail        = errors.New("email cannot be empty")
-	ErrInvalidEmail      = errors.New("invalid email format")
-	ErrUsernameTooLong   = errors.New("username exceeds maximum length")
+const userResourceType = "hello_world.User"
+
+type UserStatus string
+
+const (
+	UserStatusPending UserStatus = "pending"
+	UserStatusActive  UserStatus = "active"
 )
 
 type User struct {
 	Username string
 	Email    string
+	Status   UserStatus
 	UUID     uuid.UUID
+	// Version is bumped by the repository on every write.
+	Version int64
 }
 
-func (u *User) Validate() error {
-	if u.Username == "" {
-		return ErrEmptyUsername
-	}
-	if len(u.Username) > maxUsernameLength {
-		return ErrUsernameTooLong
-	}
-	if u.Email == "" {
-		return ErrEmptyEmail
-	}
-	if !isValidEmail(u.Email) {
-		return ErrInvalidEmail
-	}
-	return nil
+// ETag identifies the version of the user, so clients can make their writes
+// conditional on the user not having changed since they read it.
+func (u *User) ETag() string {
+	return formatETag(u.Version)
 }
 
-func isValidEmail(email string) bool {
-	_, err := mail.ParseAddress(email)
-	return err == nil
+func formatETag(version int64) string {
+	return strconv.FormatInt(version, 10)
 }
 
+// VersionConflictError reports a conditional write that found the user at
+// another version than the one it was based on.
+type VersionConflictError struct {
+	ID       uuid.UUID
+	Expected int64
+	Current  int64
+}
+
+func (e *VersionConflictError) Error() string {
+	return fmt.Sprintf("user %s is at version %d, not %d", e.ID, e.Current, e.Expected)
+}
+
+func (e *VersionConflictError) Is(target error) bool {
+	return target == helloworldErrors.ErrVersionConflict
+}
+
+func userNotFoundError(key, value string) error {
+	return helloworldErrors.NewUserNotFoundError().
+		WithMetadata(key, value).
+		WithResource(userResourceType, value)
+}
+
+// UserCursor is a position in the creation order of users. The zero cursor
+// is the start of the list.
+type UserCursor int64
+
+type ListUsersQuery struct {
+	// Status restricts the list to users with this status, or lists every user
+	// when empty.
+	Status UserStatus
+	After  UserCursor
+	Limit  int
+}
+
+type UserPage struct {
+	Users []User
+	// Next continues the list after the last user of the page, and is zero on
+	// the last page.
+	Next UserCursor
+}
+
+func versionConflictError(id uuid.UUID, expected, current int64) error {
+	stale := formatETag(expected)
+	return apperrors.New(&VersionConflictError{ID: id, Expected: expected, Current: current}).
+		WithMetadata("user_id", id.String()).
+		WithMetadata("etag", stale).
+		WithResource(userResourceType, id.String()).
+		WithPrecondition("ETAG", stale, "User was modified after this etag was read")
+}
+
+// UserRepository stores users. UpdateUser and DeleteUser are conditional:
+// they fail with a VersionConflictError unless the stored user is still at
+// the given version.
 type UserRepository interface {
-	AddUser(user User) (User, error)
-	GetUserByEmail(email string) (User, error)
-	GetUserByUsername(username string) (User, error)
-	ListUsers() []*User
+	AddUser(ctx context.Context, user User) (User, error)
+	GetUserByID(ctx context.Context, id uuid.UUID) (User, error)
+	GetUserByEmail(ctx context.Context, email string) (User, error)
+	GetUserByUsername(ctx context.Context, username string) (User, error)
+	ListUsers(ctx context.Context, query ListUsersQuery) (UserPage, error)
+	UpdateUser(ctx context.Context, user User) (User, error)
+	DeleteUser(ctx context.Context, id uuid.UUID, version int64) error
+}
+
+type storedUser struct {
+	User
+	seq UserCursor
 }
 
 type inMemoryUserRepository struct {
-	users []*User
-	mu    sync.RWMutex
+	// users is kept in creation order, so pages can be found by binary search
+	users      []*storedUser
+	byID       map[uuid.UUID]*storedUser
+	byEmail    map[string]*storedUser
+	byUsername map[string]*storedUser
+	lastSeq    UserCursor
+	mu         sync.RWMutex
 }
 
 func NewInMemoryUserRepository() UserRepository {
 	return &inMemoryUserRepository{
-		users: make([]*User, 0),
+		users:      make([]*storedUser, 0),
+		byID:       make(map[uuid.UUID]*storedUser),
+		byEmail:    make(map[string]*storedUser),
+		byUsername: make(map[string]*storedUser),
 	}
 }
 
-func (r *inMemoryUserRepository) AddUser(user User) (User, error) {
+func (r *inMemoryUserRepository) AddUser(ctx context.Context, user User) (User, error) {
+	if err := ctx.Err(); err != nil {
+		return user, err
+	}
+
 	r.mu.Lock()
 	defer r.mu.Unlock()
 
-	for _, existingUser := range r.users {
-		if existingUser.Email == user.Email {
-			return user, fmt.Errorf("%w: %s", ErrDuplicateEmail, user.Email)
-		}
-		if existingUser.Username == user.Username {
-			return user, fmt.Errorf("%w: %s", ErrDuplicateUsername, user.Username)
-		}
+	if _, ok := r.byEmail[user.Email]; ok {
+		return user, helloworldErrors.NewDuplicateEmailError(user.Email)
+	}
+	if _, ok := r.byUsername[user.Username]; ok {
+		return user, helloworldErrors.NewDuplicateUsernameError(user.Username)
 	}
 
 	user.UUID = uuid.New()
+	user.Version = 1
 
-	r.users = append(r.users, &user)
+	r.lastSeq++
+	stored := &storedUser{User: user, seq: r.lastSeq}
+	r.users = append(r.users, stored)
+	r.byID[stored.UUID] = stored
+	r.byEmail[stored.Email] = stored
+	r.byUsername[stored.Username] = stored
 	return user, nil
 }
 
-func (r *inMemoryUserRepository) GetUserByEmail(email string) (User, error) {
+func (r *inMemoryUserRepository) GetUserByID(ctx context.Context, id uuid.UUID) (User, error) {
+	if err := ctx.Err(); err != nil {
+		return User{}, err
+	}
+
 	r.mu.RLock()
 	defer r.mu.RUnlock()
 
-	for _, user := range r.users {
-		if user.Email == email {
-			return *user, nil
-		}
+	if user, ok := r.byID[id]; ok {
+		return user.User, nil
 	}
-	return User{}, fmt.Errorf("%w: %s", ErrUserNotFound, email)
+	return User{}, userNotFoundError("id", id.String())
 }
 
-func (r *inMemoryUserRepository) GetUserByUsername(username string) (User, error) {
+func (r *inMemoryUserRepository) GetUserByEmail(ctx context.Context, email string) (User, error) {
+	if err := ctx.Err(); err != nil {
+		return User{}, err
+	}
+
 	r.mu.RLock()
 	defer r.mu.RUnlock()
 
-	for _, user := range r.users {
-		if user.Username == username {
-			return *user, nil
-		}
+	if user, ok := r.byEmail[email]; ok {
+		return user.User, nil
 	}
-	return User{}, fmt.Errorf("%w: %s", ErrUserNotFound, username)
+	return User{}, userNotFoundError("email", email)
 }
 
-func (r *inMemoryUserRepository) ListUsers() []*User {
+func (r *inMemoryUserRepository) GetUserByUsername(ctx context.Context, username string) (User, error) {
+	if err := ctx.Err(); err != nil {
+		return User{}, err
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	if user, ok := r.byUsername[username]; ok {
+		return user.User, nil
+	}
+	return User{}, userNotFoundError("username", username)
+}
+
+func (r *inMemoryUserRepository) ListUsers(ctx context.Context, query ListUsersQuery) (UserPage, error) {
+	if err := ctx.Err(); err != nil {
+		return UserPage{}, err
+	}
+
 	r.mu.RLock()
 	defer r.mu.RUnlock()
 
-	users := make([]*User, len(r.users))
-	copy(users, r.users)
-	return users
+	start := sort.Search(len(r.users), func(i int) bool {
+		return r.users[i].seq > query.After
+	})
+
+	var (
+		page UserPage
+		last UserCursor
+	)
+	for _, user := range r.users[start:] {
+		if query.Status != "" && user.Status != query.Status {
+			continue
+		}
+		if len(page.Users) == query.Limit {
+			page.Next = last
+			break
+		}
+		page.Users = append(page.Users, user.User)
+		last = user.seq
+	}
+	return page, nil
+}
+
+func (r *inMemoryUserRepository) UpdateUser(ctx context.Context, user User) (User, error) {
+	if err := ctx.Err(); err != nil {
+		return user, err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	stored, ok := r.byID[user.UUID]
+	if !ok {
+		return user, userNotFoundError("id", user.UUID.String())
+	}
+	if stored.Version != user.Version {
+		return user, versionConflictError(user.UUID, user.Version, stored.Version)
+	}
+	if other, ok := r.byEmail[user.Email]; ok && other != stored {
+		return user, helloworldErrors.NewDuplicateEmailError(user.Email)
+	}
+	if other, ok := r.byUsername[user.Username]; ok && other != stored {
+		return user, helloworldErrors.NewDuplicateUsernameError(user.Username)
+	}
+
+	user.Version++
+
+	delete(r.byEmail, stored.Email)
+	delete(r.byUsername, stored.Username)
+	stored.User = user
+	r.byEmail[stored.Email] = stored
+	r.byUsername[stored.Username] = stored
+	return user, nil
+}
+
+func (r *inMemoryUserRepository) DeleteUser(ctx context.Context, id uuid.UUID, version int64) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	stored, ok := r.byID[id]
+	if !ok {
+		return userNotFoundError("id", id.String())
+	}
+	if stored.Version != version {
+		return versionConflictError(id, version, stored.Version)
+	}
+
+	i := sort.Search(len(r.users), func(i int) bool {
+		return r.users[i].seq >= stored.seq
+	})
+	r.users = slices.Delete(r.users, i, i+1)
+	delete(r.byID, stored.UUID)
+	delete(r.byEmail, stored.Email)
+	delete(r.byUsername, stored.Username)
+	return nil
 }
@@ -0,0 +1,85 @@
+package helloworld
+
+import (
+	"context"
+	"fmt"
+	"testing"
+)
+
+var benchSizes = []int{1_000, 10_000, 100_000, 1_000_000}
+
+func BenchmarkAddUser(b *testing.B) {
+	ctx := context.Background()
+	for _, size := range benchSizes {
+		b.Run(fmt.Sprintf("users=%d", size), func(b *testing.B) {
+			repo, _ := populatedRepository(b, size)
+			b.ReportAllocs()
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				if _, err := repo.AddUser(ctx, benchUser(fmt.Sprintf("bench-%d", i))); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
+
+func BenchmarkGetUserByID(b *testing.B) {
+	benchmarkGetUser(b, func(ctx context.Context, repo UserRepository, user User) error {
+		_, err := repo.GetUserByID(ctx, user.UUID)
+		return err
+	})
+}
+
+func BenchmarkGetUserByEmail(b *testing.B) {
+	benchmarkGetUser(b, func(ctx context.Context, repo UserRepository, user User) error {
+		_, err := repo.GetUserByEmail(ctx, user.Email)
+		return err
+	})
+}
+
+func BenchmarkGetUserByUsername(b *testing.B) {
+	benchmarkGetUser(b, func(ctx context.Context, repo UserRepository, user User) error {
+		_, err := repo.GetUserByUsername(ctx, user.Username)
+		return err
+	})
+}
+
+func benchmarkGetUser(b *testing.B, get func(ctx context.Context, repo UserRepository, user User) error) {
+	ctx := context.Background()
+	for _, size := range benchSizes {
+		b.Run(fmt.Sprintf("users=%d", size), func(b *testing.B) {
+			repo, users := populatedRepository(b, size)
+			b.ReportAllocs()
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				if err := get(ctx, repo, users[i%size]); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
+
+func populatedRepository(b *testing.B, size int) (UserRepository, []User) {
+	b.Helper()
+
+	ctx := context.Background()
+	repo := NewInMemoryUserRepository()
+	users := make([]User, 0, size)
+	for i := 0; i < size; i++ {
+		user, err := repo.AddUser(ctx, benchUser(fmt.Sprint(i)))
+		if err != nil {
+			b.Fatal(err)
+		}
+		users = append(users, user)
+	}
+	return repo, users
+}
+
+func benchUser(suffix string) User {
+	return User{
+		Username: "user" + suffix,
+		Email:    "user" + suffix + "@example.com",
+	}
+}
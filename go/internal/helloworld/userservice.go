@@ -2,198 +2,383 @@ package helloworld
 
 import (
 	"context"
+	"crypto/rand"
 	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"time"
 
 	helloworldPb "github.com/amirsalarsafaei/proto-error-handling/autogenerated/go/helloworld"
-	"google.golang.org/genproto/googleapis/rpc/errdetails"
-	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/status"
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	"github.com/amirsalarsafaei/proto-error-handling/go/pkg/apperrors"
+	"github.com/amirsalarsafaei/proto-error-handling/go/pkg/constraints"
+	helloworldErrors "github.com/amirsalarsafaei/proto-error-handling/go/pkg/helloworld"
+	"github.com/amirsalarsafaei/proto-error-handling/go/pkg/pagetoken"
+)
+
+const (
+	defaultPageSize = 50
+	maxPageSize     = 1000
+	pageTokenTTL    = time.Hour
 )
 
+var updatableUserFields = []string{"username", "email", "status"}
+
+// listUsersCursor is what ListUsers page tokens carry. The status filter is
+// kept so a token can't be used to continue a differently filtered list.
+type listUsersCursor struct {
+	After  UserCursor `json:"after"`
+	Status UserStatus `json:"status,omitempty"`
+}
+
 type userService struct {
 	helloworldPb.UnimplementedUserServiceServer
-	userRepo UserRepository
+	userRepo    UserRepository
+	idempotency IdempotencyStore
+	inFlight    *requestLocks
+	pageTokens  *pagetoken.Codec
+	rules       ValidationRules
+}
+
+type UserServiceOption func(*userService)
+
+// WithValidationRules normalizes and validates users with rules instead of
+// DefaultValidationRules.
+func WithValidationRules(rules ValidationRules) UserServiceOption {
+	return func(s *userService) {
+		s.rules = rules
+	}
 }
 
 func (s *userService) CreateUser(
 	ctx context.Context,
 	request *helloworldPb.CreateUserRequest,
 ) (*helloworldPb.CreateUserResponse, error) {
-	var err error
+	return idempotent(ctx, s.idempotency, s.inFlight, helloworldPb.UserService_CreateUser_FullMethodName, request,
+		func() (*helloworldPb.CreateUserResponse, error) {
+			user, err := s.createUser(ctx, request)
+			return statusResponse(user, err, createUserResponse)
+		})
+}
+
+func (s *userService) CreateUserAlt(
+	ctx context.Context,
+	request *helloworldPb.CreateUserRequest,
+) (*helloworldPb.CreateUserAltResponse, error) {
+	// the validation interceptor leaves the constraints of the request to be
+	// reported in-band
+	if err := constraints.Check(request, helloworldErrors.ConstraintError); err != nil {
+		return altResponse(User{}, err, createUserAltSuccess, createUserAltFailure)
+	}
+
+	response, err := idempotent(ctx, s.idempotency, s.inFlight, helloworldPb.UserService_CreateUserAlt_FullMethodName, request,
+		func() (*helloworldPb.CreateUserAltResponse, error) {
+			user, err := s.createUser(ctx, request)
+			return statusResponse(user, err, createUserAltSuccess)
+		})
+	// failures are reported in-band only now, so they aren't stored for
+	// replays, just like those of CreateUser
+	if err != nil {
+		return altResponse(User{}, err, createUserAltSuccess, createUserAltFailure)
+	}
+	return response, nil
+}
 
+// createUser is shared by CreateUser and CreateUserAlt, which only differ in
+// how they report its result.
+func (s *userService) createUser(ctx context.Context, request *helloworldPb.CreateUserRequest) (User, error) {
 	user := User{
 		Username: request.GetUsername(),
 		Email:    request.GetEmail(),
+		Status:   UserStatusPending,
 	}
 
-	if err = user.Validate(); err != nil {
-		st := status.New(codes.InvalidArgument, "Invalid user data")
-
-		var violations []*errdetails.BadRequest_FieldViolation
-
-		switch {
-		case errors.Is(err, ErrEmptyUsername):
-			violations = append(violations, &errdetails.BadRequest_FieldViolation{
-				Field:       "username",
-				Description: "Username cannot be empty",
-			})
-		case errors.Is(err, ErrEmptyEmail):
-			violations = append(violations, &errdetails.BadRequest_FieldViolation{
-				Field:       "email",
-				Description: "Email cannot be empty",
-			})
-		case errors.Is(err, ErrInvalidEmail):
-			violations = append(violations, &errdetails.BadRequest_FieldViolation{
-				Field:       "email",
-				Description: "Invalid email format",
-			})
-		case errors.Is(err, ErrUsernameTooLong):
-			violations = append(violations, &errdetails.BadRequest_FieldViolation{
-				Field:       "username",
-				Description: "Username exceeds maximum length",
-			})
-		}
+	user = s.rules.Normalize(user)
+	if err := s.rules.Validate(user); err != nil {
+		return user, err
+	}
+	return s.userRepo.AddUser(ctx, user)
+}
 
-		br := &errdetails.BadRequest{FieldViolations: violations}
-		st, err = st.WithDetails(br, &errdetails.ErrorInfo{
-			Reason: "VALIDATION_FAILED",
-		})
-		if err != nil {
-			return nil, status.Error(codes.Internal, "Failed to add error details")
-		}
-		return nil, st.Err()
-	}
-
-	if user, err = s.userRepo.AddUser(user); err != nil {
-		switch {
-		case errors.Is(err, ErrDuplicateEmail):
-			st := status.New(codes.AlreadyExists, "User already exists")
-			br := &errdetails.BadRequest{
-				FieldViolations: []*errdetails.BadRequest_FieldViolation{{
-					Field:       "email",
-					Description: "Email already in use",
-				}},
-			}
-			st, err = st.WithDetails(br)
-			if err != nil {
-				return nil, status.Error(codes.Internal, "Failed to add error details")
-			}
-			return nil, st.Err()
-		case errors.Is(err, ErrDuplicateUsername):
-			st := status.New(codes.AlreadyExists, "User already exists")
-			br := &errdetails.BadRequest{
-				FieldViolations: []*errdetails.BadRequest_FieldViolation{{
-					Field:       "username",
-					Description: "Username already in use",
-				}},
-			}
-			st, err = st.WithDetails(br)
-			if err != nil {
-				return nil, status.Error(codes.Internal, "Failed to add error details")
+func createUserResponse(user User) *helloworldPb.CreateUserResponse {
+	return &helloworldPb.CreateUserResponse{
+		UserId: user.UUID.String(),
+		Status: userStatusToProto(user.Status),
+	}
+}
+
+func createUserAltSuccess(user User) *helloworldPb.CreateUserAltResponse {
+	return &helloworldPb.CreateUserAltResponse{
+		Result: &helloworldPb.CreateUserAltResponse_Success{
+			Success: &helloworldPb.UserData{
+				UserId: user.UUID.String(),
+				Status: userStatusToProto(user.Status),
+			},
+		},
+	}
+}
+
+func createUserAltFailure(details *helloworldPb.ErrorDetails) *helloworldPb.CreateUserAltResponse {
+	return &helloworldPb.CreateUserAltResponse{
+		Result: &helloworldPb.CreateUserAltResponse_Error{Error: details},
+	}
+}
+
+func (s *userService) GetUser(
+	ctx context.Context,
+	request *helloworldPb.GetUserRequest,
+) (*helloworldPb.GetUserResponse, error) {
+	id, err := parseUserID(request.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := s.userRepo.GetUserByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &helloworldPb.GetUserResponse{User: userToProto(user)}, nil
+}
+
+func (s *userService) UpdateUser(
+	ctx context.Context,
+	request *helloworldPb.UpdateUserRequest,
+) (*helloworldPb.UpdateUserResponse, error) {
+	var errs apperrors.MultiError
+
+	id, err := parseUserID(request.GetUser().GetUserId())
+	errs.Append(err)
+	errs.Append(validateUpdateMask(request.GetUpdateMask()))
+	if err := errs.ErrorOrNil(); err != nil {
+		return nil, err
+	}
+
+	user, err := s.userRepo.GetUserByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if err = checkETag(user, request.GetUser().GetEtag()); err != nil {
+		return nil, err
+	}
+
+	for _, path := range request.GetUpdateMask().GetPaths() {
+		switch path {
+		case "username":
+			user.Username = request.GetUser().GetUsername()
+		case "email":
+			user.Email = request.GetUser().GetEmail()
+		case "status":
+			if user.Status, err = userStatusFromProto(request.GetUser().GetStatus()); err != nil {
+				return nil, err
 			}
-			return nil, st.Err()
-		default:
-			return nil, status.Error(codes.Internal, "Failed to create user")
 		}
 	}
 
-	return &helloworldPb.CreateUserResponse{
-		UserId: user.UUID.String(),
-		Status: helloworldPb.UserStatus_USER_STATUS_PENDING,
-	}, nil
+	user = s.rules.Normalize(user)
+	if err = s.rules.Validate(user); err != nil {
+		return nil, err
+	}
+
+	if user, err = s.userRepo.UpdateUser(ctx, user); err != nil {
+		return nil, err
+	}
+
+	return &helloworldPb.UpdateUserResponse{User: userToProto(user)}, nil
 }
 
-func (s *userService) CreateUserAlt(
+func (s *userService) DeleteUser(
 	ctx context.Context,
-	request *helloworldPb.CreateUserRequest,
-) (*helloworldPb.CreateUserAltResponse, error) {
-	var err error
-	user := User{
-		Username: request.GetUsername(),
-		Email:    request.GetEmail(),
+	request *helloworldPb.DeleteUserRequest,
+) (*helloworldPb.DeleteUserResponse, error) {
+	id, err := parseUserID(request.GetUserId())
+	if err != nil {
+		return nil, err
 	}
 
-	if err = user.Validate(); err != nil {
-		var code string
-		var message string
-
-		switch err {
-		case ErrEmptyUsername:
-			code = "VALIDATION_EMPTY_USERNAME"
-			message = "Username cannot be empty"
-		case ErrEmptyEmail:
-			code = "VALIDATION_EMPTY_EMAIL"
-			message = "Email cannot be empty"
-		case ErrInvalidEmail:
-			code = "VALIDATION_INVALID_EMAIL"
-			message = "Invalid email format"
-		case ErrUsernameTooLong:
-			code = "VALIDATION_USERNAME_TOO_LONG"
-			message = "Username exceeds maximum length"
-		default:
-			code = "VALIDATION_FAILED"
-			message = "Invalid input data"
+	user, err := s.userRepo.GetUserByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if err = checkETag(user, request.GetEtag()); err != nil {
+		return nil, err
+	}
+
+	if user.Status == UserStatusActive {
+		return nil, helloworldErrors.NewUserActiveError(id.String()).
+			WithResource(userResourceType, id.String()).
+			WithPrecondition("USER_STATUS", id.String(), "User must not be active")
+	}
+
+	if err = s.userRepo.DeleteUser(ctx, id, user.Version); err != nil {
+		return nil, err
+	}
+
+	return &helloworldPb.DeleteUserResponse{User: userToProto(user)}, nil
+}
+
+func (s *userService) ListUsers(
+	ctx context.Context,
+	request *helloworldPb.ListUsersRequest,
+) (*helloworldPb.ListUsersResponse, error) {
+	var (
+		cursor listUsersCursor
+		err    error
+	)
+	if request.GetStatus() != helloworldPb.UserStatus_USER_STATUS_UNSPECIFIED {
+		if cursor.Status, err = userStatusFromProto(request.GetStatus()); err != nil {
+			return nil, err
 		}
+	}
 
-		errorInfo := &helloworldPb.CreateUserAltResponse_Error{
-			Error: &helloworldPb.ErrorDetails{
-				Code:    code,
-				Message: message,
-			},
+	pageSize := int(request.GetPageSize())
+	switch {
+	case pageSize < 0:
+		return nil, helloworldErrors.NewInvalidPageSizeError(strconv.Itoa(pageSize))
+	case pageSize == 0:
+		pageSize = defaultPageSize
+	case pageSize > maxPageSize:
+		pageSize = maxPageSize
+	}
+
+	if token := request.GetPageToken(); token != "" {
+		var previous listUsersCursor
+		if err = s.pageTokens.Decode(token, &previous); err != nil {
+			return nil, err
 		}
-		return &helloworldPb.CreateUserAltResponse{
-			Result: errorInfo,
-		}, nil
-	}
-
-	if user, err = s.userRepo.AddUser(user); err != nil {
-		switch {
-		case errors.Is(err, ErrDuplicateEmail):
-			errorInfo := &helloworldPb.CreateUserAltResponse_Error{
-				Error: &helloworldPb.ErrorDetails{
-					Code:    "DUPLICATE_EMAIL",
-					Message: "Email is already in use",
-				},
-			}
-			return &helloworldPb.CreateUserAltResponse{
-				Result: errorInfo,
-			}, nil
-		case errors.Is(err, ErrDuplicateUsername):
-			errorInfo := &helloworldPb.CreateUserAltResponse_Error{
-				Error: &helloworldPb.ErrorDetails{
-					Code:    "DUPLICATE_USERNAME",
-					Message: "Username is already in use",
-				},
-			}
-			return &helloworldPb.CreateUserAltResponse{
-				Result: errorInfo,
-			}, nil
-
-		default:
-			errorInfo := &helloworldPb.CreateUserAltResponse_Error{
-				Error: &helloworldPb.ErrorDetails{
-					Code:    "INTERNAL_ERROR",
-					Message: "Failed to create user",
-				},
-			}
-			return &helloworldPb.CreateUserAltResponse{
-				Result: errorInfo,
-			}, nil
+		if previous.Status != cursor.Status {
+			return nil, apperrors.New(pagetoken.ErrInvalid).
+				WithMetadata("status", request.GetStatus().String())
 		}
+		cursor = previous
 	}
 
-	return &helloworldPb.CreateUserAltResponse{
-		Result: &helloworldPb.CreateUserAltResponse_Success{
-			Success: &helloworldPb.UserData{
-				UserId: user.UUID.String(),
-				Status: helloworldPb.UserStatus_USER_STATUS_PENDING,
-			},
-		},
-	}, nil
+	page, err := s.userRepo.ListUsers(ctx, ListUsersQuery{
+		Status: cursor.Status,
+		After:  cursor.After,
+		Limit:  pageSize,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	response := &helloworldPb.ListUsersResponse{
+		Users: make([]*helloworldPb.User, 0, len(page.Users)),
+	}
+	for _, user := range page.Users {
+		response.Users = append(response.Users, userToProto(user))
+	}
+
+	if page.Next != 0 {
+		cursor.After = page.Next
+		if response.NextPageToken, err = s.pageTokens.Encode(cursor); err != nil {
+			return nil, err
+		}
+	}
+	return response, nil
 }
 
-func NewUserService(userRepo UserRepository) helloworldPb.UserServiceServer {
-	return &userService{
-		userRepo: userRepo,
+func parseUserID(raw string) (uuid.UUID, error) {
+	id, err := uuid.Parse(raw)
+	if err != nil {
+		return uuid.Nil, helloworldErrors.NewInvalidUserIDError(raw)
+	}
+	return id, nil
+}
+
+// checkETag fails unless etag, when given, is the current etag of user. Writes
+// based on user are conditional on its version either way, so they also fail
+// if user changes before they're applied.
+func checkETag(user User, etag string) error {
+	if etag == "" {
+		return nil
+	}
+
+	version, err := strconv.ParseInt(etag, 10, 64)
+	if err != nil {
+		return helloworldErrors.NewInvalidETagError(etag)
+	}
+	if version != user.Version {
+		return versionConflictError(user.UUID, version, user.Version)
+	}
+	return nil
+}
+
+func validateUpdateMask(mask *fieldmaskpb.FieldMask) error {
+	if len(mask.GetPaths()) == 0 {
+		return helloworldErrors.ErrEmptyUpdateMask
+	}
+
+	var errs apperrors.MultiError
+	for i, path := range mask.GetPaths() {
+		if !slices.Contains(updatableUserFields, path) {
+			errs.Append(helloworldErrors.NewInvalidUpdateMaskPathError(path).
+				WithField(fmt.Sprintf("update_mask.paths[%d]", i)))
+		}
+	}
+	return errs.ErrorOrNil()
+}
+
+func userToProto(user User) *helloworldPb.User {
+	return &helloworldPb.User{
+		UserId:   user.UUID.String(),
+		Username: user.Username,
+		Email:    user.Email,
+		Status:   userStatusToProto(user.Status),
+		Etag:     user.ETag(),
+	}
+}
+
+func userStatusToProto(status UserStatus) helloworldPb.UserStatus {
+	switch status {
+	case UserStatusActive:
+		return helloworldPb.UserStatus_USER_STATUS_ACTIVE
+	case UserStatusPending:
+		return helloworldPb.UserStatus_USER_STATUS_PENDING
+	default:
+		return helloworldPb.UserStatus_USER_STATUS_UNSPECIFIED
+	}
+}
+
+func userStatusFromProto(status helloworldPb.UserStatus) (UserStatus, error) {
+	switch status {
+	case helloworldPb.UserStatus_USER_STATUS_ACTIVE:
+		return UserStatusActive, nil
+	case helloworldPb.UserStatus_USER_STATUS_PENDING:
+		return UserStatusPending, nil
+	default:
+		return "", helloworldErrors.NewInvalidUserStatusError(status.String())
+	}
+}
+
+func isContextError(err error) bool {
+	return errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded)
+}
+
+func NewUserService(
+	userRepo UserRepository,
+	idempotency IdempotencyStore,
+	opts ...UserServiceOption,
+) helloworldPb.UserServiceServer {
+	// page tokens only need to outlive the listing they belong to, so a key
+	// per process is enough; tokens of a previous run are rejected as invalid
+	key := make([]byte, 32)
+	if _, err := rand.Read(key); err != nil {
+		panic(fmt.Sprintf("could not generate page token key: %v", err))
+	}
+
+	s := &userService{
+		userRepo:    userRepo,
+		idempotency: idempotency,
+		inFlight:    newRequestLocks(),
+		pageTokens:  pagetoken.NewCodec(key, pageTokenTTL),
+		rules:       DefaultValidationRules,
+	}
+	for _, opt := range opts {
+		opt(s)
 	}
+	return s
 }
@@ -0,0 +1,47 @@
+package helloworld
+
+import (
+	"context"
+	"slices"
+	"testing"
+	"time"
+
+	helloworldPb "github.com/amirsalarsafaei/proto-error-handling/autogenerated/go/helloworld"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	"github.com/amirsalarsafaei/proto-error-handling/go/pkg/conformance"
+)
+
+func TestUpdateUserReportsEveryInvalidPath(t *testing.T) {
+	ctx := context.Background()
+	client := conformance.Serve(t, NewUserService(NewInMemoryUserRepository(), NewInMemoryIdempotencyStore(time.Hour)))
+
+	created, err := client.CreateUser(ctx, &helloworldPb.CreateUserRequest{Username: "alice", Email: "alice@example.com"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = client.UpdateUser(ctx, &helloworldPb.UpdateUserRequest{
+		User:       &helloworldPb.User{UserId: created.GetUserId(), Username: "bob"},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"bogus", "username", "user_id"}},
+	})
+
+	st := status.Convert(err)
+	if st.Code() != codes.InvalidArgument {
+		t.Fatalf("got %v, want InvalidArgument", err)
+	}
+	var fields []string
+	for _, detail := range st.Details() {
+		if badRequest, ok := detail.(*errdetails.BadRequest); ok {
+			for _, v := range badRequest.GetFieldViolations() {
+				fields = append(fields, v.GetField())
+			}
+		}
+	}
+	want := []string{"update_mask.paths[0]", "update_mask.paths[2]"}
+	if !slices.Equal(fields, want) {
+		t.Errorf("got violations of %v, want %v", fields, want)
+	}
+}
@@ -0,0 +1,280 @@
+package helloworld
+
+import (
+	"bytes"
+	"embed"
+	"errors"
+	"fmt"
+	"io"
+	"net/mail"
+	"os"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+
+	"golang.org/x/text/unicode/norm"
+	"google.golang.org/grpc/codes"
+	"gopkg.in/yaml.v3"
+
+	"github.com/amirsalarsafaei/proto-error-handling/go/pkg/apperrors"
+	helloworldErrors "github.com/amirsalarsafaei/proto-error-handling/go/pkg/helloworld"
+)
+
+// the rules the server can be started with by name, one file per name
+//
+//go:embed validation/*.yaml
+var embeddedValidationRules embed.FS
+
+// ValidationRules are the constraints users must meet to be stored. They are
+// declared in YAML or JSON, so every deployment can have a policy of its own,
+// e.g.
+//
+//	username:
+//	  normalization: NFKC
+//	  rules:
+//	    - required: true
+//	      reason: VALIDATION_EMPTY_USERNAME
+//	    - blocked: [admin, root]
+//	      reason: VALIDATION_USERNAME_BLOCKED
+//	      message: This username is reserved
+//	email:
+//	  rules:
+//	    - domains: [example.com]
+//	      reason: VALIDATION_EMAIL_DOMAIN_NOT_ALLOWED
+type ValidationRules struct {
+	Username FieldRules `yaml:"username"`
+	Email    FieldRules `yaml:"email"`
+}
+
+// FieldRules are the rules of a field. They are checked in order and only the
+// first rule a value breaks is reported.
+type FieldRules struct {
+	// Normalization is the Unicode normalization form, NFC, NFD, NFKC or
+	// NFKD, values are converted to before they are checked and stored.
+	Normalization string `yaml:"normalization"`
+	Rules         []Rule `yaml:"rules"`
+}
+
+var normalizationForms = map[string]norm.Form{
+	"NFC":  norm.NFC,
+	"NFD":  norm.NFD,
+	"NFKC": norm.NFKC,
+	"NFKD": norm.NFKD,
+}
+
+// Rule is a constraint, set by exactly one of its constraint fields. Rules
+// other than Required don't apply to empty values.
+type Rule struct {
+	Required bool `yaml:"required"`
+	// MinLength and MaxLength count characters, not bytes.
+	MinLength int    `yaml:"min_length"`
+	MaxLength int    `yaml:"max_length"`
+	Pattern   string `yaml:"pattern"`
+	// Email requires an address net/mail can parse.
+	Email bool `yaml:"email"`
+	// Domains lists the email domains that are allowed.
+	Domains []string `yaml:"domains"`
+	// Blocked lists the values that are not allowed, e.g. reserved usernames.
+	Blocked []string `yaml:"blocked"`
+
+	// Reason is the ErrorReason a value breaking the rule is reported with.
+	// Message, if set, replaces the message of the reason in the field
+	// violation.
+	Reason  string `yaml:"reason"`
+	Message string `yaml:"message"`
+
+	field    string
+	allows   func(value string) bool
+	sentinel error
+}
+
+var DefaultValidationRules = mustLoadValidationRules("default")
+
+// LoadValidationRules loads the rules embedded in the binary as name, default
+// or strict, or else the rules in the YAML or JSON file at path name.
+func LoadValidationRules(name string) (ValidationRules, error) {
+	data, err := embeddedValidationRules.ReadFile("validation/" + name + ".yaml")
+	if err != nil {
+		if data, err = os.ReadFile(name); err != nil {
+			return ValidationRules{}, fmt.Errorf("could not read %q: %w", name, err)
+		}
+	}
+
+	rules, err := ParseValidationRules(data)
+	if err != nil {
+		return ValidationRules{}, fmt.Errorf("could not parse %q: %w", name, err)
+	}
+	return rules, nil
+}
+
+func mustLoadValidationRules(name string) ValidationRules {
+	rules, err := LoadValidationRules(name)
+	if err != nil {
+		panic(err)
+	}
+	return rules
+}
+
+// ParseValidationRules parses rules from YAML or JSON, which is valid YAML.
+func ParseValidationRules(data []byte) (ValidationRules, error) {
+	decoder := yaml.NewDecoder(bytes.NewReader(data))
+	decoder.KnownFields(true)
+
+	var rules ValidationRules
+	if err := decoder.Decode(&rules); err != nil && !errors.Is(err, io.EOF) {
+		return ValidationRules{}, err
+	}
+
+	if err := rules.Username.compile("username"); err != nil {
+		return ValidationRules{}, err
+	}
+	if err := rules.Email.compile("email"); err != nil {
+		return ValidationRules{}, err
+	}
+	return rules, nil
+}
+
+func (f *FieldRules) compile(field string) error {
+	if _, ok := normalizationForms[f.Normalization]; !ok && f.Normalization != "" {
+		return fmt.Errorf("%s: unknown normalization form %q", field, f.Normalization)
+	}
+
+	for i := range f.Rules {
+		if err := f.Rules[i].compile(field, f.normalize); err != nil {
+			return fmt.Errorf("%s: rule %d: %w", field, i+1, err)
+		}
+	}
+	return nil
+}
+
+func (r *Rule) compile(field string, normalize func(string) string) error {
+	var constraints int
+	if r.Required {
+		constraints++
+		r.allows = func(value string) bool {
+			return value != ""
+		}
+	}
+	if minLength := r.MinLength; minLength > 0 {
+		constraints++
+		r.allows = func(value string) bool {
+			return utf8.RuneCountInString(value) >= minLength
+		}
+	}
+	if maxLength := r.MaxLength; maxLength > 0 {
+		constraints++
+		r.allows = func(value string) bool {
+			return utf8.RuneCountInString(value) <= maxLength
+		}
+	}
+	if r.Pattern != "" {
+		constraints++
+		pattern, err := regexp.Compile(r.Pattern)
+		if err != nil {
+			return err
+		}
+		r.allows = pattern.MatchString
+	}
+	if r.Email {
+		constraints++
+		r.allows = isValidEmail
+	}
+	if domains := r.Domains; len(domains) > 0 {
+		constraints++
+		r.allows = func(value string) bool {
+			domain, ok := emailDomain(value)
+			return ok && containsFold(domains, domain)
+		}
+	}
+	if len(r.Blocked) > 0 {
+		constraints++
+		blocked := make([]string, 0, len(r.Blocked))
+		for _, value := range r.Blocked {
+			blocked = append(blocked, normalize(value))
+		}
+		r.allows = func(value string) bool {
+			return !containsFold(blocked, value)
+		}
+	}
+	if constraints != 1 {
+		return fmt.Errorf("sets %d constraints, want 1", constraints)
+	}
+
+	reason, ok := helloworldErrors.ParseErrorReason(r.Reason)
+	if !ok {
+		return fmt.Errorf("unknown reason %q", r.Reason)
+	}
+	mapping, _ := helloworldErrors.ErrorReasonMapping(reason)
+	sentinel, ok := helloworldErrors.ErrorReasonSentinel(reason)
+	if !ok || mapping.Code != codes.InvalidArgument {
+		return fmt.Errorf("%s is not a validation reason", r.Reason)
+	}
+
+	r.field = field
+	r.sentinel = sentinel
+	return nil
+}
+
+func (r Rule) error() error {
+	return apperrors.New(r.sentinel).WithField(r.field).WithDescription(r.Message)
+}
+
+func (f FieldRules) normalize(value string) string {
+	if form, ok := normalizationForms[f.Normalization]; ok {
+		return form.String(value)
+	}
+	return value
+}
+
+// check returns the error of the first rule value breaks.
+func (f FieldRules) check(value string) error {
+	for _, rule := range f.Rules {
+		if value == "" && !rule.Required {
+			continue
+		}
+		if !rule.allows(value) {
+			return rule.error()
+		}
+	}
+	return nil
+}
+
+// Normalize returns user with its fields in the normalization forms of their
+// rules.
+func (r ValidationRules) Normalize(user User) User {
+	user.Username = r.Username.normalize(user.Username)
+	user.Email = r.Email.normalize(user.Email)
+	return user
+}
+
+// Validate reports every field of user that breaks a rule. User should be
+// normalized first.
+func (r ValidationRules) Validate(user User) error {
+	var errs apperrors.MultiError
+	errs.Append(r.Username.check(user.Username))
+	errs.Append(r.Email.check(user.Email))
+	return errs.ErrorOrNil()
+}
+
+func isValidEmail(email string) bool {
+	_, err := mail.ParseAddress(email)
+	return err == nil
+}
+
+func emailDomain(email string) (string, bool) {
+	address, err := mail.ParseAddress(email)
+	if err != nil {
+		return "", false
+	}
+	at := strings.LastIndexByte(address.Address, '@')
+	return address.Address[at+1:], at >= 0
+}
+
+func containsFold(values []string, value string) bool {
+	for _, v := range values {
+		if strings.EqualFold(v, value) {
+			return true
+		}
+	}
+	return false
+}
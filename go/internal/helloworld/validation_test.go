@@ -0,0 +1,260 @@
+package helloworld
+
+import (
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+
+	helloworldErrors "github.com/amirsalarsafaei/proto-error-handling/go/pkg/helloworld"
+)
+
+// violations lists the field and reason of every violation of err, e.g.
+// "username VALIDATION_EMPTY_USERNAME".
+func violations(err error) []string {
+	if err == nil {
+		return nil
+	}
+	var found []string
+	for _, v := range helloworldErrors.Registry().Resolve(err).Violations {
+		found = append(found, v.Field+" "+v.Reason)
+	}
+	return found
+}
+
+func mustParseValidationRules(t *testing.T, data string) ValidationRules {
+	t.Helper()
+	rules, err := ParseValidationRules([]byte(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return rules
+}
+
+func TestParseValidationRulesErrors(t *testing.T) {
+	tests := []struct {
+		name  string
+		rules string
+		want  string
+	}{
+		{
+			"several constraints",
+			"username:\n  rules:\n    - {required: true, max_length: 5, reason: VALIDATION_EMPTY_USERNAME}",
+			"username: rule 1: sets 2 constraints, want 1",
+		},
+		{
+			"no constraint",
+			"username:\n  rules:\n    - {reason: VALIDATION_EMPTY_USERNAME}",
+			"username: rule 1: sets 0 constraints, want 1",
+		},
+		{
+			"unknown constraint",
+			"username:\n  rules:\n    - {max_bytes: 5, reason: VALIDATION_USERNAME_TOO_LONG}",
+			"field max_bytes not found",
+		},
+		{
+			"unknown field",
+			"phone:\n  rules: []",
+			"field phone not found",
+		},
+		{
+			"unknown reason",
+			"email:\n  rules:\n    - {email: true, reason: EMAIL_BAD}",
+			`email: rule 1: unknown reason "EMAIL_BAD"`,
+		},
+		{
+			"not a validation reason",
+			"email:\n  rules:\n    - {email: true, reason: DUPLICATE_EMAIL}",
+			"email: rule 1: DUPLICATE_EMAIL is not a validation reason",
+		},
+		{
+			"aggregate reason",
+			"email:\n  rules:\n    - {email: true, reason: VALIDATION_FAILED}",
+			"email: rule 1: VALIDATION_FAILED is not a validation reason",
+		},
+		{
+			"invalid pattern",
+			"username:\n  rules:\n    - {pattern: '[a-', reason: VALIDATION_INVALID_USERNAME}",
+			"username: rule 1: error parsing regexp",
+		},
+		{
+			"unknown normalization",
+			"username:\n  normalization: NFX\n  rules: []",
+			`username: unknown normalization form "NFX"`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ParseValidationRules([]byte(tt.rules))
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("got error %v, want one containing %q", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidationRules(t *testing.T) {
+	rules := mustParseValidationRules(t, `
+username:
+  normalization: NFKC
+  rules:
+    - required: true
+      reason: VALIDATION_EMPTY_USERNAME
+    - min_length: 3
+      reason: VALIDATION_USERNAME_TOO_SHORT
+    - max_length: 8
+      reason: VALIDATION_USERNAME_TOO_LONG
+    - pattern: '^[a-z]+$'
+      reason: VALIDATION_INVALID_USERNAME
+    - blocked: [admin, ｒｏｏｔ]
+      reason: VALIDATION_USERNAME_BLOCKED
+      message: This username is reserved
+email:
+  rules:
+    - email: true
+      reason: VALIDATION_INVALID_EMAIL
+    - domains: [example.com, example.org]
+      reason: VALIDATION_EMAIL_DOMAIN_NOT_ALLOWED
+    - blocked: [noreply@example.com]
+      reason: VALIDATION_INVALID_EMAIL
+`)
+
+	tests := []struct {
+		name     string
+		username string
+		email    string
+		want     []string
+	}{
+		{"valid", "alice", "alice@example.com", nil},
+		{"empty username", "", "alice@example.com", []string{"username VALIDATION_EMPTY_USERNAME"}},
+		{"too short", "al", "alice@example.com", []string{"username VALIDATION_USERNAME_TOO_SHORT"}},
+		{"length counts characters", "éééééé", "alice@example.com", []string{"username VALIDATION_INVALID_USERNAME"}},
+		{"too long", "alexandria", "alice@example.com", []string{"username VALIDATION_USERNAME_TOO_LONG"}},
+		{"only the first broken rule", "A", "alice@example.com", []string{"username VALIDATION_USERNAME_TOO_SHORT"}},
+		{"pattern", "alice1", "alice@example.com", []string{"username VALIDATION_INVALID_USERNAME"}},
+		{"blocked", "admin", "alice@example.com", []string{"username VALIDATION_USERNAME_BLOCKED"}},
+		{"blocked after normalization", "ａｄｍｉｎ", "alice@example.com", []string{"username VALIDATION_USERNAME_BLOCKED"}},
+		{"blocked value normalized", "root", "alice@example.com", []string{"username VALIDATION_USERNAME_BLOCKED"}},
+		{"empty email without required", "alice", "", nil},
+		{"invalid email", "alice", "not an email", []string{"email VALIDATION_INVALID_EMAIL"}},
+		{"allowed domain", "alice", "alice@example.org", nil},
+		{"domains ignore case", "alice", "alice@EXAMPLE.com", nil},
+		{"domain not allowed", "alice", "alice@example.net", []string{"email VALIDATION_EMAIL_DOMAIN_NOT_ALLOWED"}},
+		{"subdomain not allowed", "alice", "alice@mail.example.com", []string{"email VALIDATION_EMAIL_DOMAIN_NOT_ALLOWED"}},
+		{"blocked address", "alice", "noreply@example.com", []string{"email VALIDATION_INVALID_EMAIL"}},
+		{"every field", "", "alice@example.net", []string{
+			"username VALIDATION_EMPTY_USERNAME",
+			"email VALIDATION_EMAIL_DOMAIN_NOT_ALLOWED",
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			user := rules.Normalize(User{Username: tt.username, Email: tt.email})
+			if got := violations(rules.Validate(user)); !slices.Equal(got, tt.want) {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidationRulesNormalize(t *testing.T) {
+	rules := mustParseValidationRules(t, "username:\n  normalization: NFKC\nemail:\n  normalization: NFC")
+
+	user := rules.Normalize(User{Username: "ｆｕｌｌ", Email: "e\u0301@example.com"})
+	if user.Username != "full" {
+		t.Errorf("got username %q, want NFKC form %q", user.Username, "full")
+	}
+	if user.Email != "\u00e9@example.com" {
+		t.Errorf("got email %q, want NFC form %q", user.Email, "\u00e9@example.com")
+	}
+}
+
+func TestValidationRulesMessage(t *testing.T) {
+	rules := mustParseValidationRules(t, `
+username:
+  rules:
+    - blocked: [admin]
+      reason: VALIDATION_USERNAME_BLOCKED
+      message: This username is reserved
+    - max_length: 3
+      reason: VALIDATION_USERNAME_TOO_LONG
+`)
+
+	resolved := helloworldErrors.Registry().Resolve(rules.Validate(User{Username: "admin"}))
+	if len(resolved.Violations) != 1 || resolved.Violations[0].Description != "This username is reserved" {
+		t.Errorf("got violations %v, want the message of the rule", resolved.Violations)
+	}
+
+	// without a message of its own, a rule is described by its reason
+	resolved = helloworldErrors.Registry().Resolve(rules.Validate(User{Username: "alice"}))
+	if len(resolved.Violations) != 1 || resolved.Violations[0].Description != "Username exceeds maximum length" {
+		t.Errorf("got violations %v, want the message of the reason", resolved.Violations)
+	}
+}
+
+func TestLoadValidationRules(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "rules.json")
+	json := `{"username": {"rules": [{"max_length": 4, "reason": "VALIDATION_USERNAME_TOO_LONG"}]}}`
+	if err := os.WriteFile(path, []byte(json), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	rules, err := LoadValidationRules(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := violations(rules.Validate(User{Username: "alice"})); !slices.Equal(got, []string{"username VALIDATION_USERNAME_TOO_LONG"}) {
+		t.Errorf("rules from %s: got %v", path, got)
+	}
+
+	if _, err := LoadValidationRules("lenient"); err == nil {
+		t.Error("loaded rules of an unknown name")
+	}
+	if _, err := LoadValidationRules(filepath.Join(t.TempDir(), "missing.yaml")); err == nil {
+		t.Error("loaded rules of a missing file")
+	}
+
+	invalid := filepath.Join(t.TempDir(), "invalid.yaml")
+	if err := os.WriteFile(invalid, []byte("username: {rules: [{required: true}]}"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := LoadValidationRules(invalid); err == nil || !strings.Contains(err.Error(), invalid) {
+		t.Errorf("got error %v, want one naming %s", err, invalid)
+	}
+}
+
+func TestEmbeddedValidationRules(t *testing.T) {
+	tests := []struct {
+		rules    string
+		username string
+		email    string
+		want     []string
+	}{
+		{"default", "alice", "alice@example.com", nil},
+		{"default", "", "", []string{"username VALIDATION_EMPTY_USERNAME", "email VALIDATION_EMPTY_EMAIL"}},
+		{"default", strings.Repeat("a", 50), "alice@example.com", nil},
+		{"default", strings.Repeat("a", 51), "alice@example.com", []string{"username VALIDATION_USERNAME_TOO_LONG"}},
+		{"default", "al", "not an email", []string{"email VALIDATION_INVALID_EMAIL"}},
+		{"default", "alice_1", "alice@example.com", nil},
+		{"strict", "alice_1", "alice@example.com", nil},
+		{"strict", "", "alice@example.com", []string{"username VALIDATION_EMPTY_USERNAME"}},
+		{"strict", "al", "alice@example.com", []string{"username VALIDATION_USERNAME_TOO_SHORT"}},
+		{"strict", strings.Repeat("a", 31), "alice@example.com", []string{"username VALIDATION_USERNAME_TOO_LONG"}},
+		{"strict", "1alice", "alice@example.com", []string{"username VALIDATION_INVALID_USERNAME"}},
+		{"strict", "alice-b", "", []string{"username VALIDATION_INVALID_USERNAME", "email VALIDATION_EMPTY_EMAIL"}},
+		{"strict", "alice", "not an email", []string{"email VALIDATION_INVALID_EMAIL"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.rules+"/"+tt.username+"/"+tt.email, func(t *testing.T) {
+			rules, err := LoadValidationRules(tt.rules)
+			if err != nil {
+				t.Fatal(err)
+			}
+			user := rules.Normalize(User{Username: tt.username, Email: tt.email})
+			if got := violations(rules.Validate(user)); !slices.Equal(got, tt.want) {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
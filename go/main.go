@@ -2,24 +2,45 @@ package main
 
 import (
 	"context"
+	"database/sql"
+	"errors"
 	"flag"
 	"fmt"
 	"log/slog"
 	"net"
+	"net/http"
 	"os"
 	"os/signal"
+	"sort"
+	"strings"
 	"syscall"
+	"time"
 
+	"connectrpc.com/connect"
 	helloworldPb "github.com/amirsalarsafaei/proto-error-handling/autogenerated/go/helloworld"
+	"github.com/amirsalarsafaei/proto-error-handling/autogenerated/go/helloworld/helloworldconnect"
+	"github.com/google/uuid"
+	"golang.org/x/net/http2"
+	"golang.org/x/net/http2/h2c"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/reflection"
-	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
 
 	"github.com/amirsalarsafaei/proto-error-handling/go/internal/helloworld"
+	helloworldErrors "github.com/amirsalarsafaei/proto-error-handling/go/pkg/helloworld"
+	"github.com/amirsalarsafaei/proto-error-handling/go/pkg/interceptors"
+	"github.com/amirsalarsafaei/proto-error-handling/go/pkg/problem"
+	"github.com/amirsalarsafaei/proto-error-handling/go/pkg/ratelimit"
+	"github.com/amirsalarsafaei/proto-error-handling/go/pkg/statusdetails"
 )
 
+// idempotencyTTL is how long a CreateUser request can be retried under the
+// same request ID.
+const idempotencyTTL = 24 * time.Hour
+
 var defaultMarshaler = protojson.MarshalOptions{
 	Indent:    "\t",
 	Multiline: true,
@@ -31,17 +52,50 @@ func main() {
 		fmt.Println("Available commands:")
 		fmt.Println("  server   Start the gRPC server")
 		fmt.Println("  client   Start the gRPC client")
+		fmt.Println("  gateway  Serve the gRPC server as an HTTP/JSON API")
 		os.Exit(1)
 	}
 
 	command := os.Args[1]
 	switch command {
 	case "server":
-		serve()
+		serverCmd := flag.NewFlagSet("server", flag.ExitOnError)
+		addr := serverCmd.String("addr", "127.0.0.1:8000", "Address to serve gRPC on")
+		devMode := serverCmd.Bool("dev", false, "Attach debug details to internal errors")
+		localesDir := serverCmd.String("locales", "", "Directory of error message catalogs (defaults to the embedded ones)")
+		dbPath := serverCmd.String("db", "", "SQLite database file to store users in (defaults to an in-memory store)")
+		rateLimits := serverCmd.String("rate-limits", "", "JSON file of per-caller rate limits (no limits by default)")
+		validation := serverCmd.String("validation", "default", "Validation rules for users: default, strict to match the Rust server, or a YAML or JSON file of rules")
+		connectAddr := serverCmd.String("connect-addr", "127.0.0.1:8001", "Address to serve the Connect and gRPC-Web protocols on (empty to disable)")
+		corsOrigins := serverCmd.String("cors-origins", "", "Comma-separated origins of the pages that may call the Connect server, or * for any (same origin only by default)")
+
+		err := serverCmd.Parse(os.Args[2:])
+		if err != nil {
+			fmt.Println("Error parsing server flags:", err)
+			os.Exit(1)
+		}
+
+		rules, err := helloworld.LoadValidationRules(*validation)
+		if err != nil {
+			fmt.Println("Error loading validation rules:", err)
+			os.Exit(1)
+		}
+
+		var origins []string
+		if *corsOrigins != "" {
+			for _, origin := range strings.Split(*corsOrigins, ",") {
+				origins = append(origins, strings.TrimSpace(origin))
+			}
+		}
+
+		serve(*addr, *devMode, *localesDir, *dbPath, *rateLimits, *connectAddr, origins, rules)
 	case "client":
 		clientCmd := flag.NewFlagSet("client", flag.ExitOnError)
 		username := clientCmd.String("username", "", "Username for the new user")
 		email := clientCmd.String("email", "", "Email for the new user")
+		lang := clientCmd.String("lang", "", "Preferred languages for error messages, e.g. \"fa, en;q=0.8\"")
+		requestID := clientCmd.String("request-id", "", "Request ID that makes retries of the call safe")
+		alt := clientCmd.Bool("alt", false, "Call CreateUserAlt, which reports errors in the response")
 
 		err := clientCmd.Parse(os.Args[2:])
 		if err != nil {
@@ -55,38 +109,127 @@ func main() {
 			os.Exit(1)
 		}
 
-		client(*username, *email)
+		client(*username, *email, *lang, *requestID, *alt)
+	case "gateway":
+		gatewayCmd := flag.NewFlagSet("gateway", flag.ExitOnError)
+		addr := gatewayCmd.String("addr", ":8080", "Address to serve the HTTP/JSON API on")
+		backend := gatewayCmd.String("backend", "localhost:8000", "Address of the gRPC server")
+		problemTypeBase := gatewayCmd.String("problem-type-base", "", "URI that error reasons are appended to as problem types (about:blank by default)")
+
+		err := gatewayCmd.Parse(os.Args[2:])
+		if err != nil {
+			fmt.Println("Error parsing gateway flags:", err)
+			os.Exit(1)
+		}
+
+		gateway(*addr, *backend, *problemTypeBase)
 	default:
 		fmt.Printf("Unknown command: %s\n", command)
 		os.Exit(1)
 	}
 }
 
-func serve() {
+func serve(
+	addr string,
+	devMode bool,
+	localesDir, dbPath, rateLimits, connectAddr string,
+	corsOrigins []string,
+	rules helloworld.ValidationRules,
+) {
 	logger := slog.New(slog.NewJSONHandler(os.Stdout, &slog.HandlerOptions{
 		Level: slog.LevelWarn,
 	}))
 	slog.SetDefault(logger)
 
 	userRepo := helloworld.NewInMemoryUserRepository()
-	userService := helloworld.NewUserService(userRepo)
+	idempotencyStore := helloworld.NewInMemoryIdempotencyStore(idempotencyTTL)
+	if dbPath != "" {
+		db, err := openDatabase(dbPath)
+		if err != nil {
+			slog.Error("could not open database", slog.Any("error", err))
+			return
+		}
+		defer db.Close()
+
+		userRepo = helloworld.NewSQLUserRepository(db)
+		idempotencyStore = helloworld.NewSQLIdempotencyStore(db, idempotencyTTL)
+	}
+	userService := helloworld.NewUserService(userRepo, idempotencyStore, helloworld.WithValidationRules(rules))
+
+	catalog, err := helloworld.LoadMessageCatalog(localesDir)
+	if err != nil {
+		slog.Error("could not load message catalog", slog.Any("error", err))
+		return
+	}
 
-	server := grpc.NewServer()
+	errorInterceptor := interceptors.NewErrorInterceptor(
+		helloworldErrors.Registry(),
+		interceptors.WithDevMode(devMode),
+		interceptors.WithCatalog(catalog),
+	)
+
+	unaryInterceptors := []grpc.UnaryServerInterceptor{errorInterceptor.Unary()}
+	streamInterceptors := []grpc.StreamServerInterceptor{errorInterceptor.Stream()}
+	if rateLimits != "" {
+		cfg, err := ratelimit.LoadConfig(rateLimits)
+		if err != nil {
+			slog.Error("could not load rate limits", slog.Any("error", err))
+			return
+		}
+
+		// runs inside the error interceptor, so rejections get localized too
+		rateLimitInterceptor := interceptors.NewRateLimitInterceptor(
+			ratelimit.NewLimiter(cfg),
+			helloworldPb.ErrorReason_RATE_LIMIT_EXCEEDED.String(),
+			helloworldErrors.Registry().Domain(),
+		)
+		unaryInterceptors = append(unaryInterceptors, rateLimitInterceptor.Unary())
+		streamInterceptors = append(streamInterceptors, rateLimitInterceptor.Stream())
+	}
+
+	// requests that break the constraints declared in helloworld.proto never
+	// reach the service, except those of CreateUserAlt, which reports them
+	// in-band
+	validationInterceptor := interceptors.NewValidationInterceptor(
+		helloworldErrors.ConstraintError,
+		interceptors.WithSkippedMethods(helloworldPb.UserService_CreateUserAlt_FullMethodName),
+	)
+	unaryInterceptors = append(unaryInterceptors, validationInterceptor.Unary())
+	streamInterceptors = append(streamInterceptors, validationInterceptor.Stream())
+
+	server := grpc.NewServer(
+		grpc.ChainUnaryInterceptor(unaryInterceptors...),
+		grpc.ChainStreamInterceptor(streamInterceptors...),
+	)
 	helloworldPb.RegisterUserServiceServer(server, userService)
 	reflection.Register(server)
 
+	// browsers can't speak gRPC, so the same service and interceptors are
+	// also served with connect-go
+	path, connectHandler := helloworldconnect.NewUserServiceHandler(
+		helloworld.NewConnectUserService(userService),
+		connect.WithInterceptors(interceptors.NewConnectInterceptor(unaryInterceptors...)),
+	)
+	connectMux := http.NewServeMux()
+	connectMux.Handle(path, connectHandler)
+	connectServer := &http.Server{
+		Addr:              connectAddr,
+		Handler:           h2c.NewHandler(helloworld.WithCORS(connectMux, corsOrigins), &http2.Server{}),
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+
 	sigChan := make(chan os.Signal, 1)
 	errChan := make(chan error, 1)
 	signal.Notify(sigChan, syscall.SIGINT, syscall.SIGTERM)
 
-	lis, err := net.ListenTCP("tcp", &net.TCPAddr{IP: net.IPv4(127, 0, 0, 1), Port: 8000})
+	lis, err := net.Listen("tcp", addr)
 	if err != nil {
 		slog.Error("could not listen", slog.Any("error", err))
 		return
 	}
 
 	go func() {
-		slog.Info("starting grpc server on :8000")
+		slog.Info("starting grpc server on " + addr)
 		err := server.Serve(lis)
 		if err != nil {
 			slog.Error("could not serve grpc", slog.Any("error", err))
@@ -94,8 +237,24 @@ func serve() {
 		}
 	}()
 
+	if connectAddr != "" {
+		go func() {
+			slog.Info("starting connect server on " + connectAddr)
+			err := connectServer.ListenAndServe()
+			if err != nil && !errors.Is(err, http.ErrServerClosed) {
+				slog.Error("could not serve connect", slog.Any("error", err))
+				errChan <- err
+			}
+		}()
+	}
+
 	select {
 	case <-sigChan:
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+		if err := connectServer.Shutdown(ctx); err != nil {
+			slog.Error("could not shutdown connect server", slog.Any("error", err))
+		}
 		server.GracefulStop()
 		slog.Info("gracefully shutdown server")
 	case <-errChan:
@@ -104,16 +263,95 @@ func serve() {
 
 }
 
-func client(username, email string) {
+func gateway(addr, backend, problemTypeBase string) {
+	logger := slog.New(slog.NewJSONHandler(os.Stdout, &slog.HandlerOptions{
+		Level: slog.LevelWarn,
+	}))
+	slog.SetDefault(logger)
+
+	conn, err := grpc.NewClient(backend, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		slog.Error("could not create client", slog.Any("error", err))
+		return
+	}
+	defer conn.Close()
+
+	var problemOpts []problem.Option
+	if problemTypeBase != "" {
+		problemOpts = append(problemOpts, problem.WithTypeBase(problemTypeBase))
+	}
+
+	server := &http.Server{
+		Addr: addr,
+		Handler: helloworld.NewGateway(
+			helloworldPb.NewUserServiceClient(conn),
+			problem.NewWriter(problemOpts...),
+		),
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+
+	sigChan := make(chan os.Signal, 1)
+	errChan := make(chan error, 1)
+	signal.Notify(sigChan, syscall.SIGINT, syscall.SIGTERM)
+
+	go func() {
+		slog.Info("starting http gateway on " + addr)
+		err := server.ListenAndServe()
+		if err != nil && !errors.Is(err, http.ErrServerClosed) {
+			slog.Error("could not serve http", slog.Any("error", err))
+			errChan <- err
+		}
+	}()
+
+	select {
+	case <-sigChan:
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+		if err := server.Shutdown(ctx); err != nil {
+			slog.Error("could not shutdown gateway", slog.Any("error", err))
+			return
+		}
+		slog.Info("gracefully shutdown gateway")
+	case <-errChan:
+		slog.Error("gateway shutdown unexpectedly")
+	}
+}
+
+func openDatabase(path string) (*sql.DB, error) {
+	// concurrent writers wait for the lock instead of failing with SQLITE_BUSY
+	dsn := "file:" + path + "?_pragma=busy_timeout(5000)&_pragma=journal_mode(WAL)"
+
+	db, err := sql.Open("sqlite", dsn)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := helloworld.Migrate(context.Background(), db); err != nil {
+		db.Close()
+		return nil, err
+	}
+	return db, nil
+}
+
+func client(username, email, lang, requestID string, alt bool) {
 	ctx := context.Background()
+	if lang != "" {
+		ctx = metadata.AppendToOutgoingContext(ctx, "accept-language", lang)
+	}
 
 	logger := slog.New(slog.NewJSONHandler(os.Stdout, &slog.HandlerOptions{
 		Level: slog.LevelInfo,
 	}))
 	slog.SetDefault(logger)
 
+	// retried calls must not create the user twice
+	if requestID == "" {
+		requestID = uuid.NewString()
+	}
+
 	client, err := grpc.NewClient("localhost:8000",
 		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithChainUnaryInterceptor(interceptors.NewRetryInterceptor().Unary()),
 	)
 	if err != nil {
 		slog.Error("could not create client", slog.Any("error", err))
@@ -124,20 +362,43 @@ func client(username, email string) {
 		client,
 	)
 
-	resp, err := userClient.CreateUser(ctx, &helloworldPb.CreateUserRequest{
-		Username: username,
-		Email:    email,
-	})
-	if spb, ok := status.FromError(err); ok {
-		errorJson, err := defaultMarshaler.Marshal(spb.Proto())
+	request := &helloworldPb.CreateUserRequest{
+		Username:  username,
+		Email:     email,
+		RequestId: requestID,
+	}
+
+	var resp proto.Message
+	if alt {
+		var altResp *helloworldPb.CreateUserAltResponse
+		altResp, err = userClient.CreateUserAlt(ctx, request)
+		if err == nil {
+			// handle in-band errors the same way as status errors
+			resp, err = altResp.GetSuccess(), helloworldErrors.AltError(altResp.GetError())
+		}
+	} else {
+		resp, err = userClient.CreateUser(ctx, request)
+	}
+	if richErr, ok := statusdetails.FromError(err); ok {
+		var retryErr *interceptors.RetryError
+		if errors.As(err, &retryErr) {
+			fmt.Printf("gave up after %d attempts\n", len(retryErr.Attempts))
+		}
+
+		errorJson, err := defaultMarshaler.Marshal(richErr.Status().Proto())
 		if err != nil {
 			slog.Error("could not marshal error", slog.Any("error", err))
 			os.Exit(-1)
 		}
 
 		fmt.Println("error: ", string(errorJson))
+		printRichError(richErr, lang)
 		return
 	}
+	if err != nil {
+		slog.Error("could not create user", slog.Any("error", err))
+		os.Exit(-1)
+	}
 
 	respJson, err := defaultMarshaler.Marshal(resp)
 	if err != nil {
@@ -148,3 +409,26 @@ func client(username, email string) {
 	fmt.Println("response: ", string(respJson))
 
 }
+
+func printRichError(richErr *statusdetails.RichError, lang string) {
+	if info, ok := richErr.ErrorInfo(); ok {
+		fmt.Printf("reason: %s (%s)\n", info.Reason, info.Domain)
+	}
+
+	locale, _, _ := strings.Cut(lang, ",")
+	locale, _, _ = strings.Cut(locale, ";")
+	if message, ok := richErr.LocalizedMessage(strings.TrimSpace(locale)); ok {
+		fmt.Printf("message: %s\n", message)
+	}
+
+	violations := richErr.FieldViolations()
+	fields := make([]string, 0, len(violations))
+	for field := range violations {
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+
+	for _, field := range fields {
+		fmt.Printf("invalid %s: %s\n", field, strings.Join(violations[field], ", "))
+	}
+}
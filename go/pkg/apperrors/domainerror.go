@@ -0,0 +1,112 @@
+package apperrors
+
+import "errors"
+
+// DomainError decorates a domain error with the structured context that
+// transport layers need to describe it, e.g. the offending field and value,
+// so they don't have to parse it back out of the error message.
+type DomainError struct {
+	Field  string
+	Reason string
+	// Constraint is the ID of the declared field constraint the error
+	// reports a violation of, e.g. "string.max_len".
+	Constraint string
+	// Description, if set, replaces the message of the error's mapping in
+	// its field violation.
+	Description   string
+	Domain        string
+	Metadata      map[string]string
+	Resource      *Resource
+	Preconditions []PreconditionViolation
+	Cause         error
+}
+
+// Resource identifies the resource an error is about, e.g. the user that
+// could not be found.
+type Resource struct {
+	Type string
+	Name string
+}
+
+// PreconditionViolation describes a state the request needed but didn't
+// find, e.g. a user that must be inactive before it can be deleted.
+type PreconditionViolation struct {
+	Type        string
+	Subject     string
+	Description string
+}
+
+func New(cause error) *DomainError {
+	return &DomainError{Cause: cause}
+}
+
+func (e *DomainError) WithField(field string) *DomainError {
+	e.Field = field
+	return e
+}
+
+func (e *DomainError) WithReason(reason string) *DomainError {
+	e.Reason = reason
+	return e
+}
+
+func (e *DomainError) WithConstraint(constraintID string) *DomainError {
+	e.Constraint = constraintID
+	return e
+}
+
+func (e *DomainError) WithDescription(description string) *DomainError {
+	e.Description = description
+	return e
+}
+
+func (e *DomainError) WithDomain(domain string) *DomainError {
+	e.Domain = domain
+	return e
+}
+
+func (e *DomainError) WithMetadata(key, value string) *DomainError {
+	if e.Metadata == nil {
+		e.Metadata = make(map[string]string)
+	}
+	e.Metadata[key] = value
+	return e
+}
+
+func (e *DomainError) WithResource(resourceType, name string) *DomainError {
+	e.Resource = &Resource{Type: resourceType, Name: name}
+	return e
+}
+
+func (e *DomainError) WithPrecondition(violationType, subject, description string) *DomainError {
+	e.Preconditions = append(e.Preconditions, PreconditionViolation{
+		Type:        violationType,
+		Subject:     subject,
+		Description: description,
+	})
+	return e
+}
+
+func (e *DomainError) Error() string {
+	msg := e.Reason
+	if e.Cause != nil {
+		msg = e.Cause.Error()
+	}
+	if e.Field != "" {
+		return e.Field + ": " + msg
+	}
+	return msg
+}
+
+func (e *DomainError) Unwrap() error {
+	return e.Cause
+}
+
+// As returns the outermost DomainError in err's chain.
+func As(err error) (*DomainError, bool) {
+	var domainErr *DomainError
+	if errors.As(err, &domainErr) {
+		return domainErr, true
+	}
+	return nil, false
+}
@@ -0,0 +1,74 @@
+package apperrors
+
+import "strings"
+
+// MultiError collects several errors, e.g. every failed validation rule of a
+// request. Like the result of errors.Join, it exposes them through
+// Unwrap() []error so errors.Is and errors.As see every collected error.
+type MultiError struct {
+	errs []error
+}
+
+func (m *MultiError) Append(errs ...error) {
+	for _, err := range errs {
+		if err != nil {
+			m.errs = append(m.errs, err)
+		}
+	}
+}
+
+func (m *MultiError) Len() int {
+	return len(m.errs)
+}
+
+func (m *MultiError) Errors() []error {
+	return m.errs
+}
+
+// ErrorOrNil returns nil when no error was collected, so callers can return it
+// directly without ending up with a non-nil error interface.
+func (m *MultiError) ErrorOrNil() error {
+	if m == nil || len(m.errs) == 0 {
+		return nil
+	}
+	return m
+}
+
+func (m *MultiError) Error() string {
+	msgs := make([]string, 0, len(m.errs))
+	for _, err := range m.errs {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+func (m *MultiError) Unwrap() []error {
+	return m.errs
+}
+
+// IsMulti reports whether err aggregates several errors, either as a
+// MultiError or as the result of errors.Join.
+func IsMulti(err error) bool {
+	_, ok := err.(interface{ Unwrap() []error })
+	return ok
+}
+
+// Flatten returns the individual errors aggregated by err, expanding nested
+// multi-errors. Errors that aggregate nothing are returned as a single
+// element.
+func Flatten(err error) []error {
+	if err == nil {
+		return nil
+	}
+
+	multi, ok := err.(interface{ Unwrap() []error })
+	if !ok {
+		return []error{err}
+	}
+
+	var errs []error
+	for _, inner := range multi.Unwrap() {
+		errs = append(errs, Flatten(inner)...)
+	}
+	return errs
+}
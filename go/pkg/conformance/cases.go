@@ -0,0 +1,230 @@
+package conformance
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	helloworldPb "github.com/amirsalarsafaei/proto-error-handling/autogenerated/go/helloworld"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/proto"
+
+	helloworldErrors "github.com/amirsalarsafaei/proto-error-handling/go/pkg/helloworld"
+)
+
+const (
+	takenUsername = "taken"
+	takenEmail    = "taken@example.com"
+	reusedID      = "6f1c2a9e-4b7d-4c1e-9a53-2d8e7f0b1c4a"
+)
+
+// Cases are the error paths of CreateUser.
+func Cases() []Case {
+	return []Case{
+		{
+			Name:    "empty username",
+			Request: &helloworldPb.CreateUserRequest{Email: "user@example.com"},
+			Code:    codes.InvalidArgument,
+			Message: "Invalid user data",
+			Details: []proto.Message{
+				errorInfo("VALIDATION_FAILED", nil),
+				badRequest("username", "required", "Username cannot be empty"),
+			},
+			Alt: altError(helloworldPb.ErrorReason_VALIDATION_FAILED, "Invalid user data",
+				violation("username", helloworldPb.ErrorReason_VALIDATION_EMPTY_USERNAME, "Username cannot be empty")),
+		},
+		{
+			Name: "username too long",
+			Request: &helloworldPb.CreateUserRequest{
+				Username: strings.Repeat("a", 51),
+				Email:    "user@example.com",
+			},
+			Code:    codes.InvalidArgument,
+			Message: "Invalid user data",
+			Details: []proto.Message{
+				errorInfo("VALIDATION_FAILED", nil),
+				badRequest("username", "string.max_len", "Username exceeds maximum length"),
+			},
+			Alt: altError(helloworldPb.ErrorReason_VALIDATION_FAILED, "Invalid user data",
+				violation("username", helloworldPb.ErrorReason_VALIDATION_USERNAME_TOO_LONG, "Username exceeds maximum length")),
+		},
+		{
+			Name:    "username with whitespace",
+			Request: &helloworldPb.CreateUserRequest{Username: "user name", Email: "user@example.com"},
+			Code:    codes.InvalidArgument,
+			Message: "Invalid user data",
+			Details: []proto.Message{
+				errorInfo("VALIDATION_FAILED", nil),
+				badRequest("username", "string.pattern", "Username contains invalid characters"),
+			},
+			Alt: altError(helloworldPb.ErrorReason_VALIDATION_FAILED, "Invalid user data",
+				violation("username", helloworldPb.ErrorReason_VALIDATION_INVALID_USERNAME, "Username contains invalid characters")),
+		},
+		{
+			Name:    "empty email",
+			Request: &helloworldPb.CreateUserRequest{Username: "user"},
+			Code:    codes.InvalidArgument,
+			Message: "Invalid user data",
+			Details: []proto.Message{
+				errorInfo("VALIDATION_FAILED", nil),
+				badRequest("email", "required", "Email cannot be empty"),
+			},
+			Alt: altError(helloworldPb.ErrorReason_VALIDATION_FAILED, "Invalid user data",
+				violation("email", helloworldPb.ErrorReason_VALIDATION_EMPTY_EMAIL, "Email cannot be empty")),
+		},
+		{
+			Name:    "invalid email",
+			Request: &helloworldPb.CreateUserRequest{Username: "user", Email: "not an email"},
+			Code:    codes.InvalidArgument,
+			Message: "Invalid user data",
+			Details: []proto.Message{
+				errorInfo("VALIDATION_FAILED", nil),
+				badRequest("email", "string.email", "Invalid email format"),
+			},
+			Alt: altError(helloworldPb.ErrorReason_VALIDATION_FAILED, "Invalid user data",
+				violation("email", helloworldPb.ErrorReason_VALIDATION_INVALID_EMAIL, "Invalid email format")),
+		},
+		{
+			Name: "email too long",
+			Request: &helloworldPb.CreateUserRequest{
+				Username: "user",
+				Email:    strings.Repeat("a", 250) + "@example.com",
+			},
+			Code:    codes.InvalidArgument,
+			Message: "Invalid user data",
+			Details: []proto.Message{
+				errorInfo("VALIDATION_FAILED", nil),
+				badRequest("email", "string.max_len", "Email exceeds maximum length"),
+			},
+			Alt: altError(helloworldPb.ErrorReason_VALIDATION_FAILED, "Invalid user data",
+				violation("email", helloworldPb.ErrorReason_VALIDATION_EMAIL_TOO_LONG, "Email exceeds maximum length")),
+		},
+		{
+			Name:    "empty username and invalid email",
+			Request: &helloworldPb.CreateUserRequest{Email: "not an email"},
+			Code:    codes.InvalidArgument,
+			Message: "Invalid user data",
+			Details: []proto.Message{
+				errorInfo("VALIDATION_FAILED", nil),
+				badRequest(
+					"username", "required", "Username cannot be empty",
+					"email", "string.email", "Invalid email format",
+				),
+			},
+			Alt: altError(helloworldPb.ErrorReason_VALIDATION_FAILED, "Invalid user data",
+				violation("username", helloworldPb.ErrorReason_VALIDATION_EMPTY_USERNAME, "Username cannot be empty"),
+				violation("email", helloworldPb.ErrorReason_VALIDATION_INVALID_EMAIL, "Invalid email format")),
+		},
+		{
+			Name:    "duplicate email",
+			Setup:   createTakenUser,
+			Request: &helloworldPb.CreateUserRequest{Username: "newcomer", Email: takenEmail},
+			Code:    codes.AlreadyExists,
+			Message: "Email already in use",
+			Details: []proto.Message{
+				errorInfo("DUPLICATE_EMAIL", map[string]string{"email": takenEmail}),
+				badRequest("email", "DUPLICATE_EMAIL", "Email already in use"),
+			},
+			Alt: altError(helloworldPb.ErrorReason_DUPLICATE_EMAIL, "Email already in use",
+				violation("email", helloworldPb.ErrorReason_DUPLICATE_EMAIL, "Email already in use")),
+		},
+		{
+			Name:    "duplicate username",
+			Setup:   createTakenUser,
+			Request: &helloworldPb.CreateUserRequest{Username: takenUsername, Email: "newcomer@example.com"},
+			Code:    codes.AlreadyExists,
+			Message: "Username already in use",
+			Details: []proto.Message{
+				errorInfo("DUPLICATE_USERNAME", map[string]string{"username": takenUsername}),
+				badRequest("username", "DUPLICATE_USERNAME", "Username already in use"),
+			},
+			Alt: altError(helloworldPb.ErrorReason_DUPLICATE_USERNAME, "Username already in use",
+				violation("username", helloworldPb.ErrorReason_DUPLICATE_USERNAME, "Username already in use")),
+		},
+		{
+			Name: "request ID reused",
+			Setup: func(ctx context.Context, client helloworldPb.UserServiceClient) error {
+				request := &helloworldPb.CreateUserRequest{
+					Username:  "first",
+					Email:     "first@example.com",
+					RequestId: reusedID,
+				}
+				if _, err := client.CreateUser(ctx, request); err != nil {
+					return err
+				}
+				// only successful requests are stored, so the Alt request
+				// needs a user of its own
+				request.Username, request.Email = "first-alt", "first-alt@example.com"
+				response, err := client.CreateUserAlt(ctx, request)
+				if err == nil && response.GetError() != nil {
+					err = fmt.Errorf("CreateUserAlt failed: %s", response.GetError().GetMessage())
+				}
+				return err
+			},
+			Request: &helloworldPb.CreateUserRequest{
+				Username:  "second",
+				Email:     "second@example.com",
+				RequestId: reusedID,
+			},
+			Code:    codes.InvalidArgument,
+			Message: "Request ID was already used for a different request",
+			Details: []proto.Message{
+				errorInfo("REQUEST_ID_REUSED", map[string]string{"request_id": reusedID}),
+				badRequest("request_id", "REQUEST_ID_REUSED", "Request ID was already used for a different request"),
+			},
+			Alt: altError(helloworldPb.ErrorReason_REQUEST_ID_REUSED, "Request ID was already used for a different request",
+				violation("request_id", helloworldPb.ErrorReason_REQUEST_ID_REUSED, "Request ID was already used for a different request")),
+		},
+	}
+}
+
+func createTakenUser(ctx context.Context, client helloworldPb.UserServiceClient) error {
+	_, err := client.CreateUser(ctx, &helloworldPb.CreateUserRequest{
+		Username: takenUsername,
+		Email:    takenEmail,
+	})
+	return err
+}
+
+func errorInfo(reason string, metadata map[string]string) *errdetails.ErrorInfo {
+	return &errdetails.ErrorInfo{
+		Reason:   reason,
+		Domain:   helloworldErrors.ErrorReasonDomain,
+		Metadata: metadata,
+	}
+}
+
+func altError(
+	code helloworldPb.ErrorReason,
+	message string,
+	violations ...*helloworldPb.FieldViolation,
+) *helloworldPb.ErrorDetails {
+	return &helloworldPb.ErrorDetails{
+		Code:            code,
+		Message:         message,
+		FieldViolations: violations,
+	}
+}
+
+func violation(field string, code helloworldPb.ErrorReason, message string) *helloworldPb.FieldViolation {
+	return &helloworldPb.FieldViolation{
+		Field:   field,
+		Code:    code,
+		Message: message,
+	}
+}
+
+// badRequest is a BadRequest detail of field, reason and description
+// triples.
+func badRequest(violations ...string) *errdetails.BadRequest {
+	br := &errdetails.BadRequest{}
+	for i := 0; i+2 < len(violations); i += 3 {
+		br.FieldViolations = append(br.FieldViolations, &errdetails.BadRequest_FieldViolation{
+			Field:       violations[i],
+			Reason:      violations[i+1],
+			Description: violations[i+2],
+		})
+	}
+	return br
+}
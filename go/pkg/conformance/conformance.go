@@ -0,0 +1,208 @@
+// Package conformance checks that a UserService fails the way the API
+// promises: every case is run against CreateUser, whose status must have the
+// exact code, message and details of the case, and against CreateUserAlt,
+// whose ErrorDetails must match as well.
+//
+// The suite runs against clients, so it can check any implementation of the
+// service. Serve serves a UserServiceServer for it the way the server command
+// does, e.g. one backed by an alternative UserRepository.
+package conformance
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"strings"
+	"testing"
+
+	helloworldPb "github.com/amirsalarsafaei/proto-error-handling/autogenerated/go/helloworld"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/encoding/prototext"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/amirsalarsafaei/proto-error-handling/go/pkg/golden"
+	helloworldErrors "github.com/amirsalarsafaei/proto-error-handling/go/pkg/helloworld"
+	"github.com/amirsalarsafaei/proto-error-handling/go/pkg/interceptors"
+)
+
+const bufSize = 1 << 20
+
+// NewClientFunc returns a client of a new, empty service for each case to
+// run against.
+type NewClientFunc func(t *testing.T) helloworldPb.UserServiceClient
+
+// Case is a call that fails, with the error it must fail with.
+type Case struct {
+	Name string
+	// Setup, if set, prepares the service before the call.
+	Setup   func(ctx context.Context, client helloworldPb.UserServiceClient) error
+	Request *helloworldPb.CreateUserRequest
+
+	// Code, Message and Details are the status of CreateUser. Details are
+	// compared in order.
+	Code    codes.Code
+	Message string
+	Details []proto.Message
+
+	// Alt is the in-band error of CreateUserAlt. If nil, CreateUserAlt must
+	// fail with the status of CreateUser instead.
+	Alt *helloworldPb.ErrorDetails
+}
+
+type options struct {
+	golden *golden.Dir
+}
+
+type Option func(*options)
+
+// WithGolden also checks what every case fails with against the golden
+// files of dir: the status of CreateUser as <case>.status and the response
+// of CreateUserAlt as <case>.alt.
+func WithGolden(dir *golden.Dir) Option {
+	return func(o *options) {
+		o.golden = dir
+	}
+}
+
+// Run runs Cases against the clients of newClient, as a subtest of t per
+// case and RPC.
+func Run(t *testing.T, newClient NewClientFunc, opts ...Option) {
+	RunCases(t, newClient, Cases(), opts...)
+}
+
+func RunCases(t *testing.T, newClient NewClientFunc, cases []Case, opts ...Option) {
+	var o options
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	for _, c := range cases {
+		t.Run(c.Name, func(t *testing.T) {
+			t.Run("CreateUser", func(t *testing.T) {
+				if err := runCase(t, newClient, c, false, o); err != nil {
+					t.Error(err)
+				}
+			})
+			t.Run("CreateUserAlt", func(t *testing.T) {
+				if err := runCase(t, newClient, c, true, o); err != nil {
+					t.Error(err)
+				}
+			})
+		})
+	}
+}
+
+func runCase(t *testing.T, newClient NewClientFunc, c Case, alt bool, o options) error {
+	ctx := context.Background()
+	client := newClient(t)
+
+	if c.Setup != nil {
+		if err := c.Setup(ctx, client); err != nil {
+			return fmt.Errorf("setup failed: %w", err)
+		}
+	}
+
+	if !alt {
+		_, err := client.CreateUser(ctx, c.Request)
+		o.checkGolden(t, c, ".status", status.Convert(err).Proto())
+		return checkStatus(c, err)
+	}
+
+	response, err := client.CreateUserAlt(ctx, c.Request)
+	if c.Alt == nil {
+		o.checkGolden(t, c, ".alt", status.Convert(err).Proto())
+		return checkStatus(c, err)
+	}
+	if err != nil {
+		return fmt.Errorf("got status %v, want an in-band error", err)
+	}
+	if !proto.Equal(response.GetError(), c.Alt) {
+		return fmt.Errorf("got %s, want %s", format(response), format(c.Alt))
+	}
+	o.checkGolden(t, c, ".alt", response)
+	return nil
+}
+
+func (o options) checkGolden(t *testing.T, c Case, suffix string, m proto.Message) {
+	t.Helper()
+	if o.golden != nil {
+		o.golden.CheckMessage(t, strings.ReplaceAll(c.Name, " ", "-")+suffix, m)
+	}
+}
+
+func checkStatus(c Case, err error) error {
+	if err == nil {
+		return errors.New("call succeeded")
+	}
+	st, ok := status.FromError(err)
+	if !ok {
+		return fmt.Errorf("call failed without a status: %w", err)
+	}
+
+	var mismatches []string
+	if st.Code() != c.Code {
+		mismatches = append(mismatches, fmt.Sprintf("got code %s, want %s", st.Code(), c.Code))
+	}
+	if st.Message() != c.Message {
+		mismatches = append(mismatches, fmt.Sprintf("got message %q, want %q", st.Message(), c.Message))
+	}
+
+	details := st.Details()
+	if len(details) != len(c.Details) {
+		mismatches = append(mismatches, fmt.Sprintf("got %d details, want %d", len(details), len(c.Details)))
+	}
+	for i := 0; i < len(details) && i < len(c.Details); i++ {
+		detail, ok := details[i].(proto.Message)
+		if !ok {
+			mismatches = append(mismatches, fmt.Sprintf("detail %d: %v", i, details[i]))
+			continue
+		}
+		if !proto.Equal(detail, c.Details[i]) {
+			mismatches = append(mismatches, fmt.Sprintf("detail %d: got %s, want %s", i, format(detail), format(c.Details[i])))
+		}
+	}
+
+	if len(mismatches) > 0 {
+		return errors.New(strings.Join(mismatches, "; "))
+	}
+	return nil
+}
+
+// Serve serves service over an in-memory connection until t ends, behind the
+// same error and validation interceptors as the server command, and returns a
+// client of it.
+func Serve(t *testing.T, service helloworldPb.UserServiceServer) helloworldPb.UserServiceClient {
+	t.Helper()
+	errorInterceptor := interceptors.NewErrorInterceptor(helloworldErrors.Registry())
+	validationInterceptor := interceptors.NewValidationInterceptor(
+		helloworldErrors.ConstraintError,
+		interceptors.WithSkippedMethods(helloworldPb.UserService_CreateUserAlt_FullMethodName),
+	)
+	server := grpc.NewServer(grpc.ChainUnaryInterceptor(errorInterceptor.Unary(), validationInterceptor.Unary()))
+	helloworldPb.RegisterUserServiceServer(server, service)
+
+	lis := bufconn.Listen(bufSize)
+	go server.Serve(lis)
+	t.Cleanup(server.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufconn",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return lis.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { conn.Close() })
+	return helloworldPb.NewUserServiceClient(conn)
+}
+
+func format(m proto.Message) string {
+	return prototext.MarshalOptions{}.Format(m)
+}
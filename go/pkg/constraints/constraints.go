@@ -0,0 +1,172 @@
+// Package constraints enforces the fieldspec constraints declared on the
+// fields of proto messages, e.g.
+//
+//	string email = 2 [(fieldspec.field) = {
+//	  required: true
+//	  string: {max_len: 254, email: true}
+//	}];
+//
+// Every constraint has an ID, such as "required" or "string.max_len", that
+// its violations are reported with. Violations carry no message: callers map
+// them to the errors they report them with, which are described and
+// localized like any other.
+package constraints
+
+import (
+	"fmt"
+	"net/mail"
+	"regexp"
+	"sync"
+	"unicode/utf8"
+
+	fieldspecPb "github.com/amirsalarsafaei/proto-error-handling/autogenerated/go/fieldspec"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"github.com/amirsalarsafaei/proto-error-handling/go/pkg/apperrors"
+)
+
+// Violation is a constraint a field breaks.
+type Violation struct {
+	// Field is the path of the field from the validated message, in proto
+	// field names, e.g. "user.email" or "users[2].email".
+	Field        string
+	ConstraintID string
+}
+
+// patterns caches compiled patterns by their source.
+var patterns sync.Map
+
+// Validate returns the violations of m and of the messages in its fields. It
+// only fails if a constraint is invalid, e.g. a pattern that doesn't compile.
+func Validate(m proto.Message) ([]Violation, error) {
+	var violations []Violation
+	if err := validateMessage(m.ProtoReflect(), "", &violations); err != nil {
+		return nil, err
+	}
+	return violations, nil
+}
+
+func validateMessage(m protoreflect.Message, prefix string, violations *[]Violation) error {
+	fields := m.Descriptor().Fields()
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		path := prefix + string(fd.Name())
+
+		if err := validateField(m, fd, path, violations); err != nil {
+			return err
+		}
+		if !m.Has(fd) || fd.Message() == nil {
+			continue
+		}
+
+		var err error
+		switch value := m.Get(fd); {
+		case fd.IsList():
+			list := value.List()
+			for j := 0; j < list.Len() && err == nil; j++ {
+				err = validateMessage(list.Get(j).Message(), fmt.Sprintf("%s[%d].", path, j), violations)
+			}
+		case fd.IsMap():
+			if fd.MapValue().Message() == nil {
+				continue
+			}
+			value.Map().Range(func(key protoreflect.MapKey, value protoreflect.Value) bool {
+				err = validateMessage(value.Message(), fmt.Sprintf("%s[%q].", path, key.String()), violations)
+				return err == nil
+			})
+		default:
+			err = validateMessage(value.Message(), path+".", violations)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func validateField(m protoreflect.Message, fd protoreflect.FieldDescriptor, path string, violations *[]Violation) error {
+	spec, ok := proto.GetExtension(fd.Options(), fieldspecPb.E_Field).(*fieldspecPb.FieldConstraints)
+	if !ok || spec == nil {
+		return nil
+	}
+
+	if !m.Has(fd) {
+		if spec.GetRequired() {
+			*violations = append(*violations, Violation{Field: path, ConstraintID: "required"})
+		}
+		return nil
+	}
+
+	rules := spec.GetString_()
+	if rules == nil || fd.Kind() != protoreflect.StringKind || fd.IsList() {
+		return nil
+	}
+	stringViolations, err := validateString(m.Get(fd).String(), rules)
+	if err != nil {
+		return fmt.Errorf("%s: %w", path, err)
+	}
+	for _, v := range stringViolations {
+		v.Field = path
+		*violations = append(*violations, v)
+	}
+	return nil
+}
+
+func validateString(value string, rules *fieldspecPb.StringConstraints) ([]Violation, error) {
+	var violations []Violation
+	length := uint64(utf8.RuneCountInString(value))
+
+	if rules.MinLen != nil && length < rules.GetMinLen() {
+		violations = append(violations, Violation{ConstraintID: "string.min_len"})
+	}
+	if rules.MaxLen != nil && length > rules.GetMaxLen() {
+		violations = append(violations, Violation{ConstraintID: "string.max_len"})
+	}
+	if rules.Pattern != nil {
+		pattern, err := compile(rules.GetPattern())
+		if err != nil {
+			return nil, fmt.Errorf("invalid pattern: %w", err)
+		}
+		if !pattern.MatchString(value) {
+			violations = append(violations, Violation{ConstraintID: "string.pattern"})
+		}
+	}
+	if rules.GetEmail() && !isEmail(value) {
+		violations = append(violations, Violation{ConstraintID: "string.email"})
+	}
+	return violations, nil
+}
+
+func compile(pattern string) (*regexp.Regexp, error) {
+	if re, ok := patterns.Load(pattern); ok {
+		return re.(*regexp.Regexp), nil
+	}
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return nil, err
+	}
+	patterns.Store(pattern, re)
+	return re, nil
+}
+
+// isEmail reports whether value is a bare address, without a display name.
+func isEmail(value string) bool {
+	address, err := mail.ParseAddress(value)
+	return err == nil && address.Address == value
+}
+
+// Check validates m and returns its violations as one error, a MultiError of
+// the error toError returns for each, or nil if m has none.
+func Check(m proto.Message, toError func(Violation) error) error {
+	violations, err := Validate(m)
+	if err != nil {
+		return err
+	}
+
+	var errs apperrors.MultiError
+	for _, v := range violations {
+		errs.Append(toError(v))
+	}
+	return errs.ErrorOrNil()
+}
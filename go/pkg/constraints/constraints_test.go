@@ -0,0 +1,271 @@
+package constraints
+
+import (
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+
+	fieldspecPb "github.com/amirsalarsafaei/proto-error-handling/autogenerated/go/fieldspec"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/dynamicpb"
+
+	"github.com/amirsalarsafaei/proto-error-handling/go/pkg/apperrors"
+)
+
+// testFile declares the messages under test:
+//
+//	message Item {
+//	  string name = 1 [required, min_len: 2, max_len: 5];
+//	  string code = 2 [pattern: "^[A-Z]+$"];
+//	  string email = 3 [email: true];
+//	}
+//	message Order {
+//	  string id = 1 [required];
+//	  Item item = 2;
+//	  repeated Item items = 3;
+//	  map<string, Item> by_key = 4;
+//	  map<string, string> labels = 5;
+//	  Item gift = 6 [required];
+//	}
+func testFile(t *testing.T, pattern string) protoreflect.FileDescriptor {
+	t.Helper()
+
+	constrained := func(spec *fieldspecPb.FieldConstraints) *descriptorpb.FieldOptions {
+		options := &descriptorpb.FieldOptions{}
+		proto.SetExtension(options, fieldspecPb.E_Field, spec)
+		return options
+	}
+	field := func(name string, number int32, label descriptorpb.FieldDescriptorProto_Label, typ descriptorpb.FieldDescriptorProto_Type, typeName string) *descriptorpb.FieldDescriptorProto {
+		fd := &descriptorpb.FieldDescriptorProto{
+			Name:     proto.String(name),
+			Number:   proto.Int32(number),
+			Label:    label.Enum(),
+			Type:     typ.Enum(),
+			JsonName: proto.String(name),
+		}
+		if typeName != "" {
+			fd.TypeName = proto.String(typeName)
+		}
+		return fd
+	}
+	const (
+		optional = descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL
+		repeated = descriptorpb.FieldDescriptorProto_LABEL_REPEATED
+		str      = descriptorpb.FieldDescriptorProto_TYPE_STRING
+		message  = descriptorpb.FieldDescriptorProto_TYPE_MESSAGE
+	)
+	mapEntry := func(name, valueTypeName string) *descriptorpb.DescriptorProto {
+		value := field("value", 2, optional, str, "")
+		if valueTypeName != "" {
+			value = field("value", 2, optional, message, valueTypeName)
+		}
+		return &descriptorpb.DescriptorProto{
+			Name:    proto.String(name),
+			Field:   []*descriptorpb.FieldDescriptorProto{field("key", 1, optional, str, ""), value},
+			Options: &descriptorpb.MessageOptions{MapEntry: proto.Bool(true)},
+		}
+	}
+
+	name := field("name", 1, optional, str, "")
+	name.Options = constrained(&fieldspecPb.FieldConstraints{
+		Required: true,
+		String_:  &fieldspecPb.StringConstraints{MinLen: proto.Uint64(2), MaxLen: proto.Uint64(5)},
+	})
+	code := field("code", 2, optional, str, "")
+	code.Options = constrained(&fieldspecPb.FieldConstraints{
+		String_: &fieldspecPb.StringConstraints{Pattern: proto.String(pattern)},
+	})
+	email := field("email", 3, optional, str, "")
+	email.Options = constrained(&fieldspecPb.FieldConstraints{
+		String_: &fieldspecPb.StringConstraints{Email: true},
+	})
+	id := field("id", 1, optional, str, "")
+	id.Options = constrained(&fieldspecPb.FieldConstraints{Required: true})
+	gift := field("gift", 6, optional, message, ".constraintstest.Item")
+	gift.Options = constrained(&fieldspecPb.FieldConstraints{Required: true})
+
+	file, err := protodesc.NewFile(&descriptorpb.FileDescriptorProto{
+		Name:       proto.String("constraintstest/constraintstest.proto"),
+		Package:    proto.String("constraintstest"),
+		Syntax:     proto.String("proto3"),
+		Dependency: []string{"fieldspec/fieldspec.proto"},
+		MessageType: []*descriptorpb.DescriptorProto{
+			{
+				Name:  proto.String("Item"),
+				Field: []*descriptorpb.FieldDescriptorProto{name, code, email},
+			},
+			{
+				Name: proto.String("Order"),
+				Field: []*descriptorpb.FieldDescriptorProto{
+					id,
+					field("item", 2, optional, message, ".constraintstest.Item"),
+					field("items", 3, repeated, message, ".constraintstest.Item"),
+					field("by_key", 4, repeated, message, ".constraintstest.Order.ByKeyEntry"),
+					field("labels", 5, repeated, message, ".constraintstest.Order.LabelsEntry"),
+					gift,
+				},
+				NestedType: []*descriptorpb.DescriptorProto{
+					mapEntry("ByKeyEntry", ".constraintstest.Item"),
+					mapEntry("LabelsEntry", ""),
+				},
+			},
+		},
+	}, protoregistry.GlobalFiles)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return file
+}
+
+// builder sets the fields of dynamic messages of a test file.
+type builder struct {
+	file protoreflect.FileDescriptor
+}
+
+func (b builder) item(name, code, email string) *dynamicpb.Message {
+	m := dynamicpb.NewMessage(b.file.Messages().ByName("Item"))
+	set := func(field protoreflect.Name, value string) {
+		if value != "" {
+			m.Set(m.Descriptor().Fields().ByName(field), protoreflect.ValueOfString(value))
+		}
+	}
+	set("name", name)
+	set("code", code)
+	set("email", email)
+	return m
+}
+
+// order returns an Order that breaks no constraints, changed by edit.
+func (b builder) order(edit func(m *dynamicpb.Message)) *dynamicpb.Message {
+	m := dynamicpb.NewMessage(b.file.Messages().ByName("Order"))
+	fields := m.Descriptor().Fields()
+	m.Set(fields.ByName("id"), protoreflect.ValueOfString("o-1"))
+	m.Set(fields.ByName("gift"), protoreflect.ValueOfMessage(b.item("gift", "", "")))
+	if edit != nil {
+		edit(m)
+	}
+	return m
+}
+
+func (b builder) setItem(m *dynamicpb.Message, item *dynamicpb.Message) {
+	m.Set(m.Descriptor().Fields().ByName("item"), protoreflect.ValueOfMessage(item))
+}
+
+func violationStrings(violations []Violation) []string {
+	var found []string
+	for _, v := range violations {
+		found = append(found, v.Field+" "+v.ConstraintID)
+	}
+	return found
+}
+
+func TestValidate(t *testing.T) {
+	b := builder{file: testFile(t, "^[A-Z]+$")}
+	withItem := func(name, code, email string) func(*dynamicpb.Message) {
+		return func(m *dynamicpb.Message) { b.setItem(m, b.item(name, code, email)) }
+	}
+
+	tests := []struct {
+		name  string
+		order *dynamicpb.Message
+		want  []string
+	}{
+		{"valid", b.order(withItem("abc", "ABC", "a@example.com")), nil},
+		{"required string", b.order(func(m *dynamicpb.Message) {
+			m.Clear(m.Descriptor().Fields().ByName("id"))
+		}), []string{"id required"}},
+		{"required message", b.order(func(m *dynamicpb.Message) {
+			m.Clear(m.Descriptor().Fields().ByName("gift"))
+		}), []string{"gift required"}},
+		{"required in a nested message", b.order(withItem("", "ABC", "")), []string{"item.name required"}},
+		{"min_len", b.order(withItem("a", "", "")), []string{"item.name string.min_len"}},
+		{"min_len at the limit", b.order(withItem("ab", "", "")), nil},
+		{"max_len", b.order(withItem("abcdef", "", "")), []string{"item.name string.max_len"}},
+		{"max_len at the limit", b.order(withItem("abcde", "", "")), nil},
+		{"lengths count characters", b.order(withItem("ééééé", "", "")), nil},
+		{"pattern", b.order(withItem("abc", "abc", "")), []string{"item.code string.pattern"}},
+		{"email", b.order(withItem("abc", "", "not an email")), []string{"item.email string.email"}},
+		{"email with a display name", b.order(withItem("abc", "", "Alice <a@example.com>")), []string{"item.email string.email"}},
+		{"every broken constraint", b.order(withItem("abcdef", "abc", "bogus")), []string{
+			"item.name string.max_len",
+			"item.code string.pattern",
+			"item.email string.email",
+		}},
+		{"list", b.order(func(m *dynamicpb.Message) {
+			list := m.Mutable(m.Descriptor().Fields().ByName("items")).List()
+			list.Append(protoreflect.ValueOfMessage(b.item("abc", "", "")))
+			list.Append(protoreflect.ValueOfMessage(b.item("", "", "")))
+			list.Append(protoreflect.ValueOfMessage(b.item("abc", "x", "")))
+		}), []string{"items[1].name required", "items[2].code string.pattern"}},
+		{"map", b.order(func(m *dynamicpb.Message) {
+			items := m.Mutable(m.Descriptor().Fields().ByName("by_key")).Map()
+			items.Set(protoreflect.ValueOfString("first").MapKey(), protoreflect.ValueOfMessage(b.item("x", "", "")))
+		}), []string{`by_key["first"].name string.min_len`}},
+		{"map of strings", b.order(func(m *dynamicpb.Message) {
+			labels := m.Mutable(m.Descriptor().Fields().ByName("labels")).Map()
+			labels.Set(protoreflect.ValueOfString("key").MapKey(), protoreflect.ValueOfString("value"))
+		}), nil},
+		{"nested and top-level", b.order(func(m *dynamicpb.Message) {
+			m.Clear(m.Descriptor().Fields().ByName("id"))
+			m.Set(m.Descriptor().Fields().ByName("gift"), protoreflect.ValueOfMessage(b.item("g", "", "")))
+		}), []string{"id required", "gift.name string.min_len"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			violations, err := Validate(tt.order)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := violationStrings(violations); !slices.Equal(got, tt.want) {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidateInvalidPattern(t *testing.T) {
+	b := builder{file: testFile(t, "[A-")}
+	order := b.order(func(m *dynamicpb.Message) { b.setItem(m, b.item("abc", "ABC", "")) })
+
+	_, err := Validate(order)
+	if err == nil || !strings.Contains(err.Error(), "item.code: invalid pattern") {
+		t.Errorf("got error %v, want one naming item.code", err)
+	}
+	// a pattern is only compiled for a value to match
+	if _, err := Validate(b.order(nil)); err != nil {
+		t.Errorf("got error %v for an order without a code", err)
+	}
+}
+
+func TestCheck(t *testing.T) {
+	b := builder{file: testFile(t, "^[A-Z]+$")}
+	errBroken := errors.New("broken")
+	toError := func(v Violation) error {
+		return apperrors.New(errBroken).WithField(v.Field).WithConstraint(v.ConstraintID)
+	}
+
+	if err := Check(b.order(nil), toError); err != nil {
+		t.Errorf("got error %v for a valid order", err)
+	}
+
+	err := Check(b.order(func(m *dynamicpb.Message) {
+		m.Clear(m.Descriptor().Fields().ByName("id"))
+		b.setItem(m, b.item("abcdef", "", ""))
+	}), toError)
+	if !apperrors.IsMulti(err) || !errors.Is(err, errBroken) {
+		t.Fatalf("got %v, want a multi-error of errBroken", err)
+	}
+	var got []string
+	for _, leaf := range apperrors.Flatten(err) {
+		domainErr, _ := apperrors.As(leaf)
+		got = append(got, domainErr.Field+" "+domainErr.Constraint)
+	}
+	if want := []string{"id required", "item.name string.max_len"}; !slices.Equal(got, want) {
+		t.Errorf("got errors %v, want %v", got, want)
+	}
+}
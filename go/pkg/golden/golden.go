@@ -0,0 +1,170 @@
+// Package golden compares serialized error payloads with golden files in
+// tests, so changes to the wire format of errors show up as changes to those
+// files. Tests importing it rewrite the golden files instead of checking them
+// when run with -update.
+//
+// Messages are serialized in canonical forms that only change when the
+// messages do: indented protojson without the random whitespace protojson
+// adds, and deterministic binary. The details of statuses are sorted by type
+// first, as their order is not part of the API, and re-marshaled
+// deterministically, as the server packed them with map entries in random
+// order.
+package golden
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+
+	// registers the detail types to unpack
+	_ "google.golang.org/genproto/googleapis/rpc/errdetails"
+	spb "google.golang.org/genproto/googleapis/rpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+const (
+	jsonExt   = ".json"
+	binaryExt = ".binpb"
+)
+
+var update = flag.Bool("update", false, "rewrite the golden files instead of checking them")
+
+// Dir is a directory of golden files.
+type Dir struct {
+	path string
+}
+
+// NewDir returns the directory at path, which is relative to the package
+// under test, e.g. "testdata".
+func NewDir(path string) *Dir {
+	return &Dir{path: path}
+}
+
+// CheckMessage checks the canonical JSON and binary forms of m against the
+// golden files name.json and name.binpb.
+func (d *Dir) CheckMessage(t testing.TB, name string, m proto.Message) {
+	t.Helper()
+	m = Canonical(m)
+
+	jsonData, err := MarshalJSON(m)
+	if err != nil {
+		t.Fatalf("could not marshal %s: %v", name, err)
+	}
+	binaryData, err := MarshalBinary(m)
+	if err != nil {
+		t.Fatalf("could not marshal %s: %v", name, err)
+	}
+	d.Check(t, name+jsonExt, jsonData)
+	d.Check(t, name+binaryExt, binaryData)
+}
+
+// Check checks data against the golden file name, or with -update, writes
+// data to it.
+func (d *Dir) Check(t testing.TB, name string, data []byte) {
+	t.Helper()
+	path := filepath.Join(d.path, name)
+	if *update {
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, data, 0o644); err != nil {
+			t.Fatal(err)
+		}
+		return
+	}
+
+	want, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		t.Errorf("%s: no golden file, run with -update to create it", path)
+		return
+	}
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(data, want) {
+		t.Errorf("%s: %s, run with -update if the change is intended", path, difference(data, want))
+	}
+}
+
+// Canonical returns m with the details of a status sorted by type and
+// marshaled deterministically. Details of unknown types are kept as they
+// are. Other messages are returned as they are.
+func Canonical(m proto.Message) proto.Message {
+	st, ok := m.(*spb.Status)
+	if !ok {
+		return m
+	}
+
+	st = proto.Clone(st).(*spb.Status)
+	for _, detail := range st.Details {
+		unpacked, err := detail.UnmarshalNew()
+		if err != nil {
+			continue
+		}
+		if value, err := MarshalBinary(unpacked); err == nil {
+			detail.Value = value
+		}
+	}
+	slices.SortStableFunc(st.Details, func(a, b *anypb.Any) int {
+		return strings.Compare(a.GetTypeUrl(), b.GetTypeUrl())
+	})
+	return st
+}
+
+// MarshalJSON returns the protojson of m, indented with tabs the way the
+// client prints errors and ending in a newline.
+func MarshalJSON(m proto.Message) ([]byte, error) {
+	data, err := protojson.Marshal(m)
+	if err != nil {
+		return nil, err
+	}
+
+	var indented bytes.Buffer
+	if err := json.Indent(&indented, data, "", "\t"); err != nil {
+		return nil, err
+	}
+	indented.WriteByte('\n')
+	return indented.Bytes(), nil
+}
+
+func MarshalBinary(m proto.Message) ([]byte, error) {
+	return proto.MarshalOptions{Deterministic: true}.Marshal(m)
+}
+
+// difference describes where got first differs from want, by line for text
+// and by offset for binary data.
+func difference(got, want []byte) string {
+	if !json.Valid(want) {
+		offset := 0
+		for offset < len(got) && offset < len(want) && got[offset] == want[offset] {
+			offset++
+		}
+		return fmt.Sprintf("differs at byte %d", offset)
+	}
+
+	gotLines := strings.Split(string(got), "\n")
+	wantLines := strings.Split(string(want), "\n")
+	for i := 0; i < len(gotLines) || i < len(wantLines); i++ {
+		var gotLine, wantLine string
+		if i < len(gotLines) {
+			gotLine = gotLines[i]
+		}
+		if i < len(wantLines) {
+			wantLine = wantLines[i]
+		}
+		if gotLine != wantLine {
+			return fmt.Sprintf("line %d is %q, want %q", i+1, strings.TrimSpace(gotLine), strings.TrimSpace(wantLine))
+		}
+	}
+	return "differs"
+}
@@ -0,0 +1,51 @@
+package golden
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// multiKeyStatus returns a status whose ErrorInfo has enough metadata for
+// the entries to come out in a different order almost every time it is
+// marshaled.
+func multiKeyStatus(t *testing.T) proto.Message {
+	t.Helper()
+	metadata := make(map[string]string)
+	for i := range 16 {
+		metadata[fmt.Sprintf("key%02d", i)] = fmt.Sprintf("value %d", i)
+	}
+	st, err := status.New(codes.InvalidArgument, "Invalid request").WithDetails(
+		&errdetails.ErrorInfo{Reason: "VALIDATION_FAILED", Domain: "example.com", Metadata: metadata},
+		&errdetails.BadRequest{FieldViolations: []*errdetails.BadRequest_FieldViolation{{Field: "name", Description: "Name cannot be empty"}}},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return st.Proto()
+}
+
+func TestCanonicalDetailsAreDeterministic(t *testing.T) {
+	want, err := MarshalBinary(Canonical(multiKeyStatus(t)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for range 20 {
+		got, err := MarshalBinary(Canonical(multiKeyStatus(t)))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(got, want) {
+			t.Fatal("canonical form changed with the order of map entries")
+		}
+	}
+}
+
+func TestCheckMessage(t *testing.T) {
+	NewDir("testdata").CheckMessage(t, "multi-key-metadata.status", multiKeyStatus(t))
+}
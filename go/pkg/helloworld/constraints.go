@@ -0,0 +1,31 @@
+package helloworld
+
+import (
+	"github.com/amirsalarsafaei/proto-error-handling/go/pkg/apperrors"
+	"github.com/amirsalarsafaei/proto-error-handling/go/pkg/constraints"
+)
+
+// constraintSentinels are the sentinels the constraints declared on request
+// fields in helloworld.proto are reported with, by field and constraint ID.
+var constraintSentinels = map[string]error{
+	"username/required":       ErrValidationEmptyUsername,
+	"username/string.max_len": ErrValidationUsernameTooLong,
+	"username/string.pattern": ErrValidationInvalidUsername,
+	"email/required":          ErrValidationEmptyEmail,
+	"email/string.max_len":    ErrValidationEmailTooLong,
+	"email/string.email":      ErrValidationInvalidEmail,
+}
+
+// ConstraintError returns the error v is reported with, for
+// interceptors.NewValidationInterceptor and constraints.Check. A constraint
+// without a sentinel of its own is reported as
+// ErrValidationConstraintViolated.
+func ConstraintError(v constraints.Violation) error {
+	sentinel, ok := constraintSentinels[v.Field+"/"+v.ConstraintID]
+	if !ok {
+		return NewValidationConstraintViolatedError(v.ConstraintID).
+			WithField(v.Field).
+			WithConstraint(v.ConstraintID)
+	}
+	return apperrors.New(sentinel).WithField(v.Field).WithConstraint(v.ConstraintID)
+}
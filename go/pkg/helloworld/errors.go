@@ -0,0 +1,72 @@
+// Package helloworld holds the Go side of the error contract of the
+// helloworld UserService, for the server and its Go clients alike: the
+// sentinels and mappings generated from the ErrorReason enum, and the
+// registry that turns errors into statuses and back.
+package helloworld
+
+import (
+	"context"
+	"errors"
+
+	helloworldPb "github.com/amirsalarsafaei/proto-error-handling/autogenerated/go/helloworld"
+
+	"github.com/amirsalarsafaei/proto-error-handling/go/pkg/pagetoken"
+	"github.com/amirsalarsafaei/proto-error-handling/go/pkg/statusdetails"
+)
+
+// ErrVersionConflict is matched by the errors of writes based on a version
+// of a user that is no longer current.
+var ErrVersionConflict = errors.New("user was modified concurrently")
+
+// the mappings are declared with the ErrorReason enum in helloworld.proto;
+// reasons of errors from outside this package are registered here
+var registry = RegisterErrorReasons(statusdetails.NewRegistry(ErrorReasonDomain)).
+	SetFallback(mustErrorReasonMapping(helloworldPb.ErrorReason_INTERNAL_ERROR)).
+	Register(pagetoken.ErrInvalid, mustErrorReasonMapping(helloworldPb.ErrorReason_INVALID_PAGE_TOKEN)).
+	Register(pagetoken.ErrExpired, mustErrorReasonMapping(helloworldPb.ErrorReason_EXPIRED_PAGE_TOKEN)).
+	Register(ErrVersionConflict, mustErrorReasonMapping(helloworldPb.ErrorReason_STALE_ETAG)).
+	Register(context.DeadlineExceeded, mustErrorReasonMapping(helloworldPb.ErrorReason_DEADLINE_EXCEEDED)).
+	Register(context.Canceled, mustErrorReasonMapping(helloworldPb.ErrorReason_REQUEST_CANCELED))
+
+func mustErrorReasonMapping(reason helloworldPb.ErrorReason) statusdetails.ErrorMapping {
+	m, ok := ErrorReasonMapping(reason)
+	if !ok {
+		panic("no mapping declared for " + reason.String())
+	}
+	return m
+}
+
+// Registry returns the registry of every ErrorReason.
+func Registry() *statusdetails.Registry {
+	return registry
+}
+
+// AltError converts the in-band error of an Alt response back into an error
+// like the one the status-error RPC would have failed with: it carries the
+// same status code, ErrorInfo reason and BadRequest, and matches the
+// sentinels of its reasons with errors.Is. ErrorDetails has no metadata,
+// resource or preconditions, so the ErrorInfo has no metadata and the
+// ResourceInfo and PreconditionFailure of the status are missing. Nor does it
+// name the constraints fields break, so the BadRequest violations have
+// reasons where the status has constraint IDs. It returns nil for nil
+// details.
+func AltError(details *helloworldPb.ErrorDetails) error {
+	if details == nil {
+		return nil
+	}
+
+	violations := make([]statusdetails.FieldViolation, 0, len(details.GetFieldViolations()))
+	for _, v := range details.GetFieldViolations() {
+		violations = append(violations, statusdetails.FieldViolation{
+			Field:       v.GetField(),
+			Reason:      v.GetCode().String(),
+			Description: v.GetMessage(),
+		})
+	}
+
+	return registry.Rebuild(statusdetails.ResolvedError{
+		Reason:     details.GetCode().String(),
+		Message:    details.GetMessage(),
+		Violations: violations,
+	})
+}
@@ -0,0 +1,410 @@
+// Code generated by protoc-gen-go-errors. DO NOT EDIT.
+// source: helloworld/helloworld.proto
+
+package helloworld
+
+import (
+	"errors"
+
+	"google.golang.org/grpc/codes"
+
+	helloworldPb "github.com/amirsalarsafaei/proto-error-handling/autogenerated/go/helloworld"
+
+	"github.com/amirsalarsafaei/proto-error-handling/go/pkg/apperrors"
+	"github.com/amirsalarsafaei/proto-error-handling/go/pkg/statusdetails"
+)
+
+// ErrorReasonDomain is the ErrorInfo domain of every ErrorReason.
+const ErrorReasonDomain = "hello_world.UserService"
+
+var (
+	ErrValidationEmptyUsername         = errors.New("username cannot be empty")
+	ErrValidationUsernameTooLong       = errors.New("username exceeds maximum length")
+	ErrValidationUsernameTooShort      = errors.New("username is below minimum length")
+	ErrValidationInvalidUsername       = errors.New("username contains invalid characters")
+	ErrValidationUsernameBlocked       = errors.New("username is not allowed")
+	ErrValidationEmptyEmail            = errors.New("email cannot be empty")
+	ErrValidationInvalidEmail          = errors.New("invalid email format")
+	ErrValidationEmailDomainNotAllowed = errors.New("email domain is not allowed")
+	ErrValidationEmailTooLong          = errors.New("email exceeds maximum length")
+	ErrValidationConstraintViolated    = errors.New("value violates a constraint of the API")
+	ErrDuplicateEmail                  = errors.New("email already in use")
+	ErrDuplicateUsername               = errors.New("username already in use")
+	ErrUserNotFound                    = errors.New("user not found")
+	ErrRequestIDReused                 = errors.New("request ID was already used for a different request")
+	ErrInvalidUserID                   = errors.New("user ID must be a UUID")
+	ErrInvalidUserStatus               = errors.New("invalid user status")
+	ErrEmptyUpdateMask                 = errors.New("update mask must list the fields to update")
+	ErrInvalidUpdateMaskPath           = errors.New("update mask lists a field that cannot be updated")
+	ErrInvalidPageSize                 = errors.New("page size must not be negative")
+	ErrInvalidETag                     = errors.New("invalid etag")
+	ErrUserActive                      = errors.New("active users cannot be deleted")
+)
+
+// NewValidationEmptyUsernameError returns ErrValidationEmptyUsername as a DomainError.
+func NewValidationEmptyUsernameError() *apperrors.DomainError {
+	return apperrors.New(ErrValidationEmptyUsername)
+}
+
+// NewValidationUsernameTooLongError returns ErrValidationUsernameTooLong as a DomainError.
+func NewValidationUsernameTooLongError() *apperrors.DomainError {
+	return apperrors.New(ErrValidationUsernameTooLong)
+}
+
+// NewValidationUsernameTooShortError returns ErrValidationUsernameTooShort as a DomainError.
+func NewValidationUsernameTooShortError() *apperrors.DomainError {
+	return apperrors.New(ErrValidationUsernameTooShort)
+}
+
+// NewValidationInvalidUsernameError returns ErrValidationInvalidUsername as a DomainError.
+func NewValidationInvalidUsernameError() *apperrors.DomainError {
+	return apperrors.New(ErrValidationInvalidUsername)
+}
+
+// NewValidationUsernameBlockedError returns ErrValidationUsernameBlocked as a DomainError.
+func NewValidationUsernameBlockedError() *apperrors.DomainError {
+	return apperrors.New(ErrValidationUsernameBlocked)
+}
+
+// NewValidationEmptyEmailError returns ErrValidationEmptyEmail as a DomainError.
+func NewValidationEmptyEmailError() *apperrors.DomainError {
+	return apperrors.New(ErrValidationEmptyEmail)
+}
+
+// NewValidationInvalidEmailError returns ErrValidationInvalidEmail as a DomainError.
+func NewValidationInvalidEmailError() *apperrors.DomainError {
+	return apperrors.New(ErrValidationInvalidEmail)
+}
+
+// NewValidationEmailDomainNotAllowedError returns ErrValidationEmailDomainNotAllowed as a DomainError.
+func NewValidationEmailDomainNotAllowedError() *apperrors.DomainError {
+	return apperrors.New(ErrValidationEmailDomainNotAllowed)
+}
+
+// NewValidationEmailTooLongError returns ErrValidationEmailTooLong as a DomainError.
+func NewValidationEmailTooLongError() *apperrors.DomainError {
+	return apperrors.New(ErrValidationEmailTooLong)
+}
+
+// NewValidationConstraintViolatedError returns ErrValidationConstraintViolated as a DomainError.
+func NewValidationConstraintViolatedError(constraint string) *apperrors.DomainError {
+	return apperrors.New(ErrValidationConstraintViolated).
+		WithMetadata("constraint", constraint)
+}
+
+// NewDuplicateEmailError returns ErrDuplicateEmail as a DomainError.
+func NewDuplicateEmailError(email string) *apperrors.DomainError {
+	return apperrors.New(ErrDuplicateEmail).
+		WithMetadata("email", email)
+}
+
+// NewDuplicateUsernameError returns ErrDuplicateUsername as a DomainError.
+func NewDuplicateUsernameError(username string) *apperrors.DomainError {
+	return apperrors.New(ErrDuplicateUsername).
+		WithMetadata("username", username)
+}
+
+// NewUserNotFoundError returns ErrUserNotFound as a DomainError.
+func NewUserNotFoundError() *apperrors.DomainError {
+	return apperrors.New(ErrUserNotFound)
+}
+
+// NewRequestIDReusedError returns ErrRequestIDReused as a DomainError.
+func NewRequestIDReusedError(requestID string) *apperrors.DomainError {
+	return apperrors.New(ErrRequestIDReused).
+		WithMetadata("request_id", requestID)
+}
+
+// NewInvalidUserIDError returns ErrInvalidUserID as a DomainError.
+func NewInvalidUserIDError(userID string) *apperrors.DomainError {
+	return apperrors.New(ErrInvalidUserID).
+		WithMetadata("user_id", userID)
+}
+
+// NewInvalidUserStatusError returns ErrInvalidUserStatus as a DomainError.
+func NewInvalidUserStatusError(status string) *apperrors.DomainError {
+	return apperrors.New(ErrInvalidUserStatus).
+		WithMetadata("status", status)
+}
+
+// NewEmptyUpdateMaskError returns ErrEmptyUpdateMask as a DomainError.
+func NewEmptyUpdateMaskError() *apperrors.DomainError {
+	return apperrors.New(ErrEmptyUpdateMask)
+}
+
+// NewInvalidUpdateMaskPathError returns ErrInvalidUpdateMaskPath as a DomainError.
+func NewInvalidUpdateMaskPathError(path string) *apperrors.DomainError {
+	return apperrors.New(ErrInvalidUpdateMaskPath).
+		WithMetadata("path", path)
+}
+
+// NewInvalidPageSizeError returns ErrInvalidPageSize as a DomainError.
+func NewInvalidPageSizeError(pageSize string) *apperrors.DomainError {
+	return apperrors.New(ErrInvalidPageSize).
+		WithMetadata("page_size", pageSize)
+}
+
+// NewInvalidETagError returns ErrInvalidETag as a DomainError.
+func NewInvalidETagError(etag string) *apperrors.DomainError {
+	return apperrors.New(ErrInvalidETag).
+		WithMetadata("etag", etag)
+}
+
+// NewUserActiveError returns ErrUserActive as a DomainError.
+func NewUserActiveError(userID string) *apperrors.DomainError {
+	return apperrors.New(ErrUserActive).
+		WithMetadata("user_id", userID)
+}
+
+var errorReasonMappings = map[helloworldPb.ErrorReason]statusdetails.ErrorMapping{
+	helloworldPb.ErrorReason_VALIDATION_FAILED: {
+		Code:    codes.InvalidArgument,
+		Reason:  helloworldPb.ErrorReason_VALIDATION_FAILED.String(),
+		Message: "Invalid user data",
+	},
+	helloworldPb.ErrorReason_VALIDATION_EMPTY_USERNAME: {
+		Code:    codes.InvalidArgument,
+		Reason:  helloworldPb.ErrorReason_VALIDATION_EMPTY_USERNAME.String(),
+		Field:   "username",
+		Message: "Username cannot be empty",
+	},
+	helloworldPb.ErrorReason_VALIDATION_USERNAME_TOO_LONG: {
+		Code:    codes.InvalidArgument,
+		Reason:  helloworldPb.ErrorReason_VALIDATION_USERNAME_TOO_LONG.String(),
+		Field:   "username",
+		Message: "Username exceeds maximum length",
+	},
+	helloworldPb.ErrorReason_VALIDATION_USERNAME_TOO_SHORT: {
+		Code:    codes.InvalidArgument,
+		Reason:  helloworldPb.ErrorReason_VALIDATION_USERNAME_TOO_SHORT.String(),
+		Field:   "username",
+		Message: "Username is below minimum length",
+	},
+	helloworldPb.ErrorReason_VALIDATION_INVALID_USERNAME: {
+		Code:    codes.InvalidArgument,
+		Reason:  helloworldPb.ErrorReason_VALIDATION_INVALID_USERNAME.String(),
+		Field:   "username",
+		Message: "Username contains invalid characters",
+	},
+	helloworldPb.ErrorReason_VALIDATION_USERNAME_BLOCKED: {
+		Code:    codes.InvalidArgument,
+		Reason:  helloworldPb.ErrorReason_VALIDATION_USERNAME_BLOCKED.String(),
+		Field:   "username",
+		Message: "Username is not allowed",
+	},
+	helloworldPb.ErrorReason_VALIDATION_EMPTY_EMAIL: {
+		Code:    codes.InvalidArgument,
+		Reason:  helloworldPb.ErrorReason_VALIDATION_EMPTY_EMAIL.String(),
+		Field:   "email",
+		Message: "Email cannot be empty",
+	},
+	helloworldPb.ErrorReason_VALIDATION_INVALID_EMAIL: {
+		Code:    codes.InvalidArgument,
+		Reason:  helloworldPb.ErrorReason_VALIDATION_INVALID_EMAIL.String(),
+		Field:   "email",
+		Message: "Invalid email format",
+	},
+	helloworldPb.ErrorReason_VALIDATION_EMAIL_DOMAIN_NOT_ALLOWED: {
+		Code:    codes.InvalidArgument,
+		Reason:  helloworldPb.ErrorReason_VALIDATION_EMAIL_DOMAIN_NOT_ALLOWED.String(),
+		Field:   "email",
+		Message: "Email domain is not allowed",
+	},
+	helloworldPb.ErrorReason_VALIDATION_EMAIL_TOO_LONG: {
+		Code:    codes.InvalidArgument,
+		Reason:  helloworldPb.ErrorReason_VALIDATION_EMAIL_TOO_LONG.String(),
+		Field:   "email",
+		Message: "Email exceeds maximum length",
+	},
+	helloworldPb.ErrorReason_VALIDATION_CONSTRAINT_VIOLATED: {
+		Code:    codes.InvalidArgument,
+		Reason:  helloworldPb.ErrorReason_VALIDATION_CONSTRAINT_VIOLATED.String(),
+		Message: "Value violates a constraint of the API",
+	},
+	helloworldPb.ErrorReason_DUPLICATE_EMAIL: {
+		Code:    codes.AlreadyExists,
+		Reason:  helloworldPb.ErrorReason_DUPLICATE_EMAIL.String(),
+		Field:   "email",
+		Message: "Email already in use",
+	},
+	helloworldPb.ErrorReason_DUPLICATE_USERNAME: {
+		Code:    codes.AlreadyExists,
+		Reason:  helloworldPb.ErrorReason_DUPLICATE_USERNAME.String(),
+		Field:   "username",
+		Message: "Username already in use",
+	},
+	helloworldPb.ErrorReason_USER_NOT_FOUND: {
+		Code:    codes.NotFound,
+		Reason:  helloworldPb.ErrorReason_USER_NOT_FOUND.String(),
+		Message: "User not found",
+	},
+	helloworldPb.ErrorReason_REQUEST_ID_REUSED: {
+		Code:    codes.InvalidArgument,
+		Reason:  helloworldPb.ErrorReason_REQUEST_ID_REUSED.String(),
+		Field:   "request_id",
+		Message: "Request ID was already used for a different request",
+	},
+	helloworldPb.ErrorReason_INVALID_USER_ID: {
+		Code:    codes.InvalidArgument,
+		Reason:  helloworldPb.ErrorReason_INVALID_USER_ID.String(),
+		Field:   "user_id",
+		Message: "User ID must be a UUID",
+	},
+	helloworldPb.ErrorReason_INVALID_USER_STATUS: {
+		Code:    codes.InvalidArgument,
+		Reason:  helloworldPb.ErrorReason_INVALID_USER_STATUS.String(),
+		Field:   "status",
+		Message: "Invalid user status",
+	},
+	helloworldPb.ErrorReason_EMPTY_UPDATE_MASK: {
+		Code:    codes.InvalidArgument,
+		Reason:  helloworldPb.ErrorReason_EMPTY_UPDATE_MASK.String(),
+		Field:   "update_mask",
+		Message: "Update mask must list the fields to update",
+	},
+	helloworldPb.ErrorReason_INVALID_UPDATE_MASK_PATH: {
+		Code:    codes.InvalidArgument,
+		Reason:  helloworldPb.ErrorReason_INVALID_UPDATE_MASK_PATH.String(),
+		Field:   "update_mask",
+		Message: "Update mask lists a field that cannot be updated",
+	},
+	helloworldPb.ErrorReason_INVALID_PAGE_SIZE: {
+		Code:    codes.InvalidArgument,
+		Reason:  helloworldPb.ErrorReason_INVALID_PAGE_SIZE.String(),
+		Field:   "page_size",
+		Message: "Page size must not be negative",
+	},
+	helloworldPb.ErrorReason_INVALID_PAGE_TOKEN: {
+		Code:    codes.InvalidArgument,
+		Reason:  helloworldPb.ErrorReason_INVALID_PAGE_TOKEN.String(),
+		Field:   "page_token",
+		Message: "Invalid page token",
+	},
+	helloworldPb.ErrorReason_EXPIRED_PAGE_TOKEN: {
+		Code:    codes.InvalidArgument,
+		Reason:  helloworldPb.ErrorReason_EXPIRED_PAGE_TOKEN.String(),
+		Field:   "page_token",
+		Message: "Page token has expired",
+	},
+	helloworldPb.ErrorReason_INVALID_ETAG: {
+		Code:    codes.InvalidArgument,
+		Reason:  helloworldPb.ErrorReason_INVALID_ETAG.String(),
+		Field:   "etag",
+		Message: "Invalid etag",
+	},
+	helloworldPb.ErrorReason_STALE_ETAG: {
+		Code:    codes.Aborted,
+		Reason:  helloworldPb.ErrorReason_STALE_ETAG.String(),
+		Message: "User was modified concurrently",
+	},
+	helloworldPb.ErrorReason_USER_ACTIVE: {
+		Code:    codes.FailedPrecondition,
+		Reason:  helloworldPb.ErrorReason_USER_ACTIVE.String(),
+		Message: "Active users cannot be deleted",
+	},
+	helloworldPb.ErrorReason_DEADLINE_EXCEEDED: {
+		Code:    codes.DeadlineExceeded,
+		Reason:  helloworldPb.ErrorReason_DEADLINE_EXCEEDED.String(),
+		Message: "Request deadline exceeded",
+	},
+	helloworldPb.ErrorReason_REQUEST_CANCELED: {
+		Code:    codes.Canceled,
+		Reason:  helloworldPb.ErrorReason_REQUEST_CANCELED.String(),
+		Message: "Request canceled",
+	},
+	helloworldPb.ErrorReason_RATE_LIMIT_EXCEEDED: {
+		Code:    codes.ResourceExhausted,
+		Reason:  helloworldPb.ErrorReason_RATE_LIMIT_EXCEEDED.String(),
+		Message: "Rate limit exceeded",
+	},
+	helloworldPb.ErrorReason_INTERNAL_ERROR: {
+		Code:    codes.Internal,
+		Reason:  helloworldPb.ErrorReason_INTERNAL_ERROR.String(),
+		Message: "Internal error",
+	},
+}
+
+// ErrorReasonMapping returns the mapping declared for reason.
+func ErrorReasonMapping(reason helloworldPb.ErrorReason) (statusdetails.ErrorMapping, bool) {
+	m, ok := errorReasonMappings[reason]
+	return m, ok
+}
+
+var errorReasonSentinels = map[helloworldPb.ErrorReason]error{
+	helloworldPb.ErrorReason_VALIDATION_EMPTY_USERNAME:           ErrValidationEmptyUsername,
+	helloworldPb.ErrorReason_VALIDATION_USERNAME_TOO_LONG:        ErrValidationUsernameTooLong,
+	helloworldPb.ErrorReason_VALIDATION_USERNAME_TOO_SHORT:       ErrValidationUsernameTooShort,
+	helloworldPb.ErrorReason_VALIDATION_INVALID_USERNAME:         ErrValidationInvalidUsername,
+	helloworldPb.ErrorReason_VALIDATION_USERNAME_BLOCKED:         ErrValidationUsernameBlocked,
+	helloworldPb.ErrorReason_VALIDATION_EMPTY_EMAIL:              ErrValidationEmptyEmail,
+	helloworldPb.ErrorReason_VALIDATION_INVALID_EMAIL:            ErrValidationInvalidEmail,
+	helloworldPb.ErrorReason_VALIDATION_EMAIL_DOMAIN_NOT_ALLOWED: ErrValidationEmailDomainNotAllowed,
+	helloworldPb.ErrorReason_VALIDATION_EMAIL_TOO_LONG:           ErrValidationEmailTooLong,
+	helloworldPb.ErrorReason_VALIDATION_CONSTRAINT_VIOLATED:      ErrValidationConstraintViolated,
+	helloworldPb.ErrorReason_DUPLICATE_EMAIL:                     ErrDuplicateEmail,
+	helloworldPb.ErrorReason_DUPLICATE_USERNAME:                  ErrDuplicateUsername,
+	helloworldPb.ErrorReason_USER_NOT_FOUND:                      ErrUserNotFound,
+	helloworldPb.ErrorReason_REQUEST_ID_REUSED:                   ErrRequestIDReused,
+	helloworldPb.ErrorReason_INVALID_USER_ID:                     ErrInvalidUserID,
+	helloworldPb.ErrorReason_INVALID_USER_STATUS:                 ErrInvalidUserStatus,
+	helloworldPb.ErrorReason_EMPTY_UPDATE_MASK:                   ErrEmptyUpdateMask,
+	helloworldPb.ErrorReason_INVALID_UPDATE_MASK_PATH:            ErrInvalidUpdateMaskPath,
+	helloworldPb.ErrorReason_INVALID_PAGE_SIZE:                   ErrInvalidPageSize,
+	helloworldPb.ErrorReason_INVALID_ETAG:                        ErrInvalidETag,
+	helloworldPb.ErrorReason_USER_ACTIVE:                         ErrUserActive,
+}
+
+// ErrorReasonSentinel returns the sentinel of reason. Aggregate and external
+// reasons have none.
+func ErrorReasonSentinel(reason helloworldPb.ErrorReason) (error, bool) {
+	err, ok := errorReasonSentinels[reason]
+	return err, ok
+}
+
+// RegisterErrorReasons registers the sentinel of every ErrorReason with registry.
+// Reasons declared as external are left to the caller, to be registered
+// for errors from outside this package.
+func RegisterErrorReasons(registry *statusdetails.Registry) *statusdetails.Registry {
+	return registry.
+		SetAggregate(errorReasonMappings[helloworldPb.ErrorReason_VALIDATION_FAILED]).
+		Register(ErrValidationEmptyUsername, errorReasonMappings[helloworldPb.ErrorReason_VALIDATION_EMPTY_USERNAME]).
+		Register(ErrValidationUsernameTooLong, errorReasonMappings[helloworldPb.ErrorReason_VALIDATION_USERNAME_TOO_LONG]).
+		Register(ErrValidationUsernameTooShort, errorReasonMappings[helloworldPb.ErrorReason_VALIDATION_USERNAME_TOO_SHORT]).
+		Register(ErrValidationInvalidUsername, errorReasonMappings[helloworldPb.ErrorReason_VALIDATION_INVALID_USERNAME]).
+		Register(ErrValidationUsernameBlocked, errorReasonMappings[helloworldPb.ErrorReason_VALIDATION_USERNAME_BLOCKED]).
+		Register(ErrValidationEmptyEmail, errorReasonMappings[helloworldPb.ErrorReason_VALIDATION_EMPTY_EMAIL]).
+		Register(ErrValidationInvalidEmail, errorReasonMappings[helloworldPb.ErrorReason_VALIDATION_INVALID_EMAIL]).
+		Register(ErrValidationEmailDomainNotAllowed, errorReasonMappings[helloworldPb.ErrorReason_VALIDATION_EMAIL_DOMAIN_NOT_ALLOWED]).
+		Register(ErrValidationEmailTooLong, errorReasonMappings[helloworldPb.ErrorReason_VALIDATION_EMAIL_TOO_LONG]).
+		Register(ErrValidationConstraintViolated, errorReasonMappings[helloworldPb.ErrorReason_VALIDATION_CONSTRAINT_VIOLATED]).
+		Register(ErrDuplicateEmail, errorReasonMappings[helloworldPb.ErrorReason_DUPLICATE_EMAIL]).
+		Register(ErrDuplicateUsername, errorReasonMappings[helloworldPb.ErrorReason_DUPLICATE_USERNAME]).
+		Register(ErrUserNotFound, errorReasonMappings[helloworldPb.ErrorReason_USER_NOT_FOUND]).
+		Register(ErrRequestIDReused, errorReasonMappings[helloworldPb.ErrorReason_REQUEST_ID_REUSED]).
+		Register(ErrInvalidUserID, errorReasonMappings[helloworldPb.ErrorReason_INVALID_USER_ID]).
+		Register(ErrInvalidUserStatus, errorReasonMappings[helloworldPb.ErrorReason_INVALID_USER_STATUS]).
+		Register(ErrEmptyUpdateMask, errorReasonMappings[helloworldPb.ErrorReason_EMPTY_UPDATE_MASK]).
+		Register(ErrInvalidUpdateMaskPath, errorReasonMappings[helloworldPb.ErrorReason_INVALID_UPDATE_MASK_PATH]).
+		Register(ErrInvalidPageSize, errorReasonMappings[helloworldPb.ErrorReason_INVALID_PAGE_SIZE]).
+		Register(ErrInvalidETag, errorReasonMappings[helloworldPb.ErrorReason_INVALID_ETAG]).
+		Register(ErrUserActive, errorReasonMappings[helloworldPb.ErrorReason_USER_ACTIVE])
+}
+
+// DecodeErrorReason returns the ErrorReason of a status error from ErrorReasonDomain.
+func DecodeErrorReason(err error) (helloworldPb.ErrorReason, bool) {
+	richErr, ok := statusdetails.FromError(err)
+	if !ok {
+		return helloworldPb.ErrorReason_ERROR_REASON_UNSPECIFIED, false
+	}
+	info, ok := richErr.ErrorInfo()
+	if !ok || info.Domain != ErrorReasonDomain {
+		return helloworldPb.ErrorReason_ERROR_REASON_UNSPECIFIED, false
+	}
+	return ParseErrorReason(info.Reason)
+}
+
+// ParseErrorReason returns the ErrorReason named reason.
+func ParseErrorReason(reason string) (helloworldPb.ErrorReason, bool) {
+	value, ok := helloworldPb.ErrorReason_value[reason]
+	return helloworldPb.ErrorReason(value), ok
+}
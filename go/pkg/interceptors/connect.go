@@ -0,0 +1,88 @@
+package interceptors
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/netip"
+
+	"connectrpc.com/connect"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+// NewConnectInterceptor runs gRPC unary server interceptors on the unary
+// handlers of a connect-go server, so services served over the Connect and
+// gRPC-Web protocols fail the same way they do over gRPC.
+//
+// The interceptors see the request headers as incoming metadata and the
+// client address as peer. The status of a failed call, details included,
+// is returned as a *connect.Error.
+func NewConnectInterceptor(interceptors ...grpc.UnaryServerInterceptor) connect.Interceptor {
+	return connect.UnaryInterceptorFunc(func(next connect.UnaryFunc) connect.UnaryFunc {
+		return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+			if req.Spec().IsClient {
+				return next(ctx, req)
+			}
+
+			info := &grpc.UnaryServerInfo{FullMethod: req.Spec().Procedure}
+			handler := func(ctx context.Context, _ any) (any, error) {
+				return next(ctx, req)
+			}
+			for i := len(interceptors) - 1; i >= 0; i-- {
+				handler = chainUnary(interceptors[i], info, handler)
+			}
+
+			resp, err := handler(incomingContext(ctx, req), req.Any())
+			if err != nil {
+				return nil, connectError(err)
+			}
+			return resp.(connect.AnyResponse), nil
+		}
+	})
+}
+
+func chainUnary(interceptor grpc.UnaryServerInterceptor, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) grpc.UnaryHandler {
+	return func(ctx context.Context, req any) (any, error) {
+		return interceptor(ctx, req, info, handler)
+	}
+}
+
+func incomingContext(ctx context.Context, req connect.AnyRequest) context.Context {
+	md := metadata.MD{}
+	for key, values := range req.Header() {
+		md.Append(key, values...)
+	}
+	ctx = metadata.NewIncomingContext(ctx, md)
+
+	if addr, err := netip.ParseAddrPort(req.Peer().Addr); err == nil {
+		ctx = peer.NewContext(ctx, &peer.Peer{Addr: net.TCPAddrFromAddrPort(addr)})
+	}
+	return ctx
+}
+
+// connectError converts a status error into a *connect.Error with the same
+// code, message and details. Errors without a status are left to connect-go,
+// which reports them as unknown.
+func connectError(err error) error {
+	var connectErr *connect.Error
+	if errors.As(err, &connectErr) {
+		return err
+	}
+	st, ok := status.FromError(err)
+	if !ok {
+		return err
+	}
+
+	connectErr = connect.NewError(connect.Code(st.Code()), errors.New(st.Message()))
+	for _, detail := range st.Proto().GetDetails() {
+		errorDetail, err := connect.NewErrorDetail(detail)
+		if err != nil {
+			return err
+		}
+		connectErr.AddDetail(errorDetail)
+	}
+	return connectErr
+}
@@ -0,0 +1,163 @@
+package interceptors
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/amirsalarsafaei/proto-error-handling/go/pkg/localization"
+	"github.com/amirsalarsafaei/proto-error-handling/go/pkg/statusdetails"
+)
+
+// ErrorMapper converts a handler error into a status. The boolean reports
+// whether the error was recognised; unrecognised errors are treated as
+// internal failures.
+type ErrorMapper interface {
+	MapError(err error) (*status.Status, bool)
+}
+
+type ErrorMapperFunc func(err error) (*status.Status, bool)
+
+func (f ErrorMapperFunc) MapError(err error) (*status.Status, bool) {
+	return f(err)
+}
+
+type ErrorInterceptor struct {
+	mapper  ErrorMapper
+	catalog *localization.Catalog
+	devMode bool
+}
+
+type ErrorInterceptorOption func(*ErrorInterceptor)
+
+// WithDevMode attaches a DebugInfo detail describing the original error to
+// statuses of unrecognised errors. It must not be enabled in production as it
+// leaks internal error messages to clients.
+func WithDevMode(devMode bool) ErrorInterceptorOption {
+	return func(i *ErrorInterceptor) {
+		i.devMode = devMode
+	}
+}
+
+// WithCatalog attaches a LocalizedMessage for the ErrorInfo reason of every
+// error status, in the locale that best matches the caller's accept-language
+// metadata.
+func WithCatalog(catalog *localization.Catalog) ErrorInterceptorOption {
+	return func(i *ErrorInterceptor) {
+		i.catalog = catalog
+	}
+}
+
+func NewErrorInterceptor(mapper ErrorMapper, opts ...ErrorInterceptorOption) *ErrorInterceptor {
+	i := &ErrorInterceptor{
+		mapper: mapper,
+	}
+	for _, opt := range opts {
+		opt(i)
+	}
+	return i
+}
+
+func (i *ErrorInterceptor) Unary() grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req any,
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (any, error) {
+		resp, err := handler(ctx, req)
+		if err != nil {
+			return resp, i.convert(ctx, info.FullMethod, err)
+		}
+		return resp, nil
+	}
+}
+
+func (i *ErrorInterceptor) Stream() grpc.StreamServerInterceptor {
+	return func(
+		srv any,
+		ss grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		if err := handler(srv, ss); err != nil {
+			return i.convert(ss.Context(), info.FullMethod, err)
+		}
+		return nil
+	}
+}
+
+func (i *ErrorInterceptor) convert(ctx context.Context, method string, err error) error {
+	if st, ok := status.FromError(err); ok {
+		return i.localize(ctx, st).Err()
+	}
+
+	st, known := i.mapper.MapError(err)
+	if known {
+		return i.localize(ctx, st).Err()
+	}
+
+	slog.Error("unhandled error in grpc handler",
+		"method", method,
+		"error", err)
+
+	if st == nil || st.Code() == codes.OK {
+		st = status.New(codes.Internal, "Internal error")
+	}
+	if i.devMode {
+		st = statusdetails.StatusWithDetails(st, &errdetails.DebugInfo{
+			StackEntries: errorChain(err),
+			Detail:       err.Error(),
+		})
+	}
+	return i.localize(ctx, st).Err()
+}
+
+func (i *ErrorInterceptor) localize(ctx context.Context, st *status.Status) *status.Status {
+	if i.catalog == nil {
+		return st
+	}
+
+	var errorInfo *errdetails.ErrorInfo
+	for _, detail := range st.Details() {
+		switch d := detail.(type) {
+		case *errdetails.LocalizedMessage:
+			return st
+		case *errdetails.ErrorInfo:
+			errorInfo = d
+		}
+	}
+	if errorInfo == nil {
+		return st
+	}
+
+	md, _ := metadata.FromIncomingContext(ctx)
+	locale, message, ok := i.catalog.Localize(
+		errorInfo.GetReason(),
+		errorInfo.GetMetadata(),
+		md.Get("accept-language")...,
+	)
+	if !ok {
+		return st
+	}
+
+	return statusdetails.StatusWithDetails(st, &errdetails.LocalizedMessage{
+		Locale:  locale,
+		Message: message,
+	})
+}
+
+func errorChain(err error) []string {
+	var chain []string
+	for ; err != nil; err = errors.Unwrap(err) {
+		chain = append(chain, fmt.Sprintf("%T: %v", err, err))
+	}
+	return chain
+}
@@ -0,0 +1,141 @@
+package interceptors
+
+import (
+	"context"
+	"fmt"
+	"net"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"github.com/amirsalarsafaei/proto-error-handling/go/pkg/ratelimit"
+	"github.com/amirsalarsafaei/proto-error-handling/go/pkg/statusdetails"
+)
+
+// RateLimitInterceptor rejects calls over the limits of a ratelimit.Limiter
+// with ResourceExhausted, naming the exhausted quota in a QuotaFailure and
+// when to retry in a RetryInfo.
+//
+// Callers are identified by their address, unless a CallerFunc identifies
+// them otherwise.
+type RateLimitInterceptor struct {
+	limiter *ratelimit.Limiter
+	reason  string
+	domain  string
+	caller  CallerFunc
+}
+
+// CallerFunc identifies the caller of a call, or reports false if it can't.
+type CallerFunc func(ctx context.Context) (string, bool)
+
+type RateLimitOption func(*RateLimitInterceptor)
+
+// WithCaller identifies callers with caller, e.g. by the identity they
+// authenticated with. caller must not trust what callers say about
+// themselves, such as a metadata entry they set: a caller sending a new
+// identity with every call would get a new bucket with every call.
+func WithCaller(caller CallerFunc) RateLimitOption {
+	return func(i *RateLimitInterceptor) {
+		i.caller = caller
+	}
+}
+
+// NewRateLimitInterceptor returns an interceptor enforcing the limits of
+// limiter. reason and domain are the ErrorInfo reason and domain of
+// rejections.
+func NewRateLimitInterceptor(
+	limiter *ratelimit.Limiter,
+	reason, domain string,
+	opts ...RateLimitOption,
+) *RateLimitInterceptor {
+	i := &RateLimitInterceptor{
+		limiter: limiter,
+		reason:  reason,
+		domain:  domain,
+	}
+	for _, opt := range opts {
+		opt(i)
+	}
+	return i
+}
+
+func (i *RateLimitInterceptor) Unary() grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req any,
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (any, error) {
+		if err := i.allow(ctx, info.FullMethod); err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+func (i *RateLimitInterceptor) Stream() grpc.StreamServerInterceptor {
+	return func(
+		srv any,
+		ss grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		if err := i.allow(ss.Context(), info.FullMethod); err != nil {
+			return err
+		}
+		return handler(srv, ss)
+	}
+}
+
+func (i *RateLimitInterceptor) allow(ctx context.Context, method string) error {
+	caller := i.identify(ctx)
+	decision := i.limiter.Allow(caller, method)
+	if decision.Allowed {
+		return nil
+	}
+
+	st := statusdetails.StatusWithDetails(
+		status.New(codes.ResourceExhausted, "Rate limit exceeded"),
+		&errdetails.ErrorInfo{
+			Reason: i.reason,
+			Domain: i.domain,
+			Metadata: map[string]string{
+				"method": method,
+			},
+		},
+		&errdetails.QuotaFailure{
+			Violations: []*errdetails.QuotaFailure_Violation{{
+				Subject:     caller,
+				Description: fmt.Sprintf("%s is limited to %s", method, decision.Limit),
+			}},
+		},
+		&errdetails.RetryInfo{
+			RetryDelay: durationpb.New(decision.RetryAfter),
+		},
+	)
+	return st.Err()
+}
+
+// identify identifies the caller as "caller:<id>" with the CallerFunc, or
+// as "clientip:<address>" from the peer address.
+func (i *RateLimitInterceptor) identify(ctx context.Context) string {
+	if i.caller != nil {
+		if id, ok := i.caller(ctx); ok {
+			return "caller:" + id
+		}
+	}
+
+	p, ok := peer.FromContext(ctx)
+	if !ok || p.Addr == nil {
+		return "clientip:unknown"
+	}
+	host, _, err := net.SplitHostPort(p.Addr.String())
+	if err != nil {
+		host = p.Addr.String()
+	}
+	return "clientip:" + host
+}
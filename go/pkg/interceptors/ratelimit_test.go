@@ -0,0 +1,91 @@
+package interceptors
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+
+	"github.com/amirsalarsafaei/proto-error-handling/go/pkg/ratelimit"
+	"github.com/amirsalarsafaei/proto-error-handling/go/pkg/statusdetails"
+)
+
+func TestRateLimitRejection(t *testing.T) {
+	const method = "/hello_world.UserService/CreateUser"
+	limiter := ratelimit.NewLimiter(ratelimit.Config{
+		Default: &ratelimit.Limit{RequestsPerSecond: 0.5, Burst: 1},
+	})
+	interceptor := NewRateLimitInterceptor(limiter, "RATE_LIMITED", "example.com").Unary()
+	handler := func(ctx context.Context, req any) (any, error) { return req, nil }
+	info := &grpc.UnaryServerInfo{FullMethod: method}
+
+	call := func(address, callerID string) error {
+		ctx := peer.NewContext(context.Background(), &peer.Peer{
+			Addr: &net.TCPAddr{IP: net.ParseIP(address), Port: 4242},
+		})
+		ctx = metadata.NewIncomingContext(ctx, metadata.Pairs("x-caller-id", callerID))
+		_, err := interceptor(ctx, "request", info, handler)
+		return err
+	}
+
+	if err := call("192.0.2.1", "first"); err != nil {
+		t.Fatalf("first call rejected: %v", err)
+	}
+	// a new caller ID from the same address doesn't get a new bucket
+	err := call("192.0.2.1", "second")
+	richErr, ok := statusdetails.FromError(err)
+	if !ok || richErr.Code() != codes.ResourceExhausted {
+		t.Fatalf("got %v, want ResourceExhausted", err)
+	}
+
+	errorInfo, _ := richErr.ErrorInfo()
+	if errorInfo.Reason != "RATE_LIMITED" || errorInfo.Domain != "example.com" || errorInfo.Metadata["method"] != method {
+		t.Errorf("got ErrorInfo %+v", errorInfo)
+	}
+	violations := richErr.QuotaViolations()
+	if len(violations) != 1 || violations[0].GetSubject() != "clientip:192.0.2.1" {
+		t.Errorf("got quota violations %v, want one of clientip:192.0.2.1", violations)
+	}
+	if delay, ok := richErr.RetryDelay(); !ok || delay <= time.Second || delay > 2*time.Second {
+		t.Errorf("got retry delay %v, want up to 2s", delay)
+	}
+
+	if err := call("192.0.2.2", "second"); err != nil {
+		t.Errorf("call from another address rejected: %v", err)
+	}
+}
+
+func TestRateLimitCallerFunc(t *testing.T) {
+	limiter := ratelimit.NewLimiter(ratelimit.Config{
+		Default: &ratelimit.Limit{RequestsPerSecond: 0.5, Burst: 1},
+	})
+	interceptor := NewRateLimitInterceptor(limiter, "RATE_LIMITED", "example.com", WithCaller(func(ctx context.Context) (string, bool) {
+		user, ok := ctx.Value(userKey{}).(string)
+		return user, ok
+	})).Unary()
+	handler := func(ctx context.Context, req any) (any, error) { return req, nil }
+	info := &grpc.UnaryServerInfo{FullMethod: "/hello_world.UserService/GetUser"}
+
+	for _, user := range []string{"alice", "bob"} {
+		ctx := context.WithValue(context.Background(), userKey{}, user)
+		if _, err := interceptor(ctx, "request", info, handler); err != nil {
+			t.Errorf("first call of %s rejected: %v", user, err)
+		}
+	}
+	ctx := context.WithValue(context.Background(), userKey{}, "alice")
+	_, err := interceptor(ctx, "request", info, handler)
+	richErr, ok := statusdetails.FromError(err)
+	if !ok || richErr.Code() != codes.ResourceExhausted {
+		t.Fatalf("got %v, want ResourceExhausted", err)
+	}
+	if violations := richErr.QuotaViolations(); len(violations) != 1 || violations[0].GetSubject() != "caller:alice" {
+		t.Errorf("got quota violations %v, want one of caller:alice", violations)
+	}
+}
+
+type userKey struct{}
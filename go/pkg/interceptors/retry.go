@@ -0,0 +1,207 @@
+package interceptors
+
+import (
+	"context"
+	"fmt"
+	"math/rand/v2"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/amirsalarsafaei/proto-error-handling/go/pkg/statusdetails"
+)
+
+// RetryInterceptor retries unary calls that fail with a retryable error,
+// waiting with exponential backoff and jitter between attempts.
+//
+// An error is retryable if its ErrorInfo reason is registered as retryable.
+// Errors whose reason is registered as not retryable are never retried.
+// Otherwise, errors carrying a RetryInfo are retried, as are errors with a
+// retryable code.
+type RetryInterceptor struct {
+	maxAttempts    int
+	initialBackoff time.Duration
+	maxBackoff     time.Duration
+	multiplier     float64
+	jitter         float64
+	codes          map[codes.Code]bool
+	reasons        map[string]bool
+}
+
+type RetryOption func(*RetryInterceptor)
+
+// WithMaxAttempts limits the number of attempts, including the first one.
+func WithMaxAttempts(attempts int) RetryOption {
+	return func(i *RetryInterceptor) {
+		i.maxAttempts = attempts
+	}
+}
+
+// WithBackoff sets the delay before the first retry, the factor it grows by
+// with every further retry and the longest delay.
+func WithBackoff(initial, longest time.Duration, multiplier float64) RetryOption {
+	return func(i *RetryInterceptor) {
+		i.initialBackoff = initial
+		i.maxBackoff = longest
+		i.multiplier = multiplier
+	}
+}
+
+// WithJitter sets the fraction of each delay that is randomized, between 0
+// and 1, so clients that failed together don't retry together.
+func WithJitter(jitter float64) RetryOption {
+	return func(i *RetryInterceptor) {
+		i.jitter = jitter
+	}
+}
+
+// WithRetryableCodes replaces the codes that are retried.
+func WithRetryableCodes(retryable ...codes.Code) RetryOption {
+	return func(i *RetryInterceptor) {
+		i.codes = make(map[codes.Code]bool, len(retryable))
+		for _, code := range retryable {
+			i.codes[code] = true
+		}
+	}
+}
+
+// WithRetryableReason decides whether errors with reason are retried,
+// regardless of their code.
+func WithRetryableReason(reason string, retryable bool) RetryOption {
+	return func(i *RetryInterceptor) {
+		i.reasons[reason] = retryable
+	}
+}
+
+func NewRetryInterceptor(opts ...RetryOption) *RetryInterceptor {
+	i := &RetryInterceptor{
+		maxAttempts:    4,
+		initialBackoff: 100 * time.Millisecond,
+		maxBackoff:     5 * time.Second,
+		multiplier:     2,
+		jitter:         0.2,
+		// Aborted isn't retried by default: a conflicting write, such as one
+		// based on a stale etag, fails the same way when sent again
+		codes: map[codes.Code]bool{
+			codes.Unavailable:       true,
+			codes.ResourceExhausted: true,
+		},
+		reasons: make(map[string]bool),
+	}
+	for _, opt := range opts {
+		opt(i)
+	}
+	return i
+}
+
+func (i *RetryInterceptor) Unary() grpc.UnaryClientInterceptor {
+	return func(
+		ctx context.Context,
+		method string,
+		req, reply any,
+		cc *grpc.ClientConn,
+		invoker grpc.UnaryInvoker,
+		opts ...grpc.CallOption,
+	) error {
+		var attempts []error
+		for {
+			err := invoker(ctx, method, req, reply, cc, opts...)
+			if err == nil {
+				return nil
+			}
+			attempts = append(attempts, err)
+
+			delay, retry := i.nextDelay(ctx, len(attempts), err)
+			if !retry {
+				return retryError(method, attempts)
+			}
+
+			timer := time.NewTimer(delay)
+			select {
+			case <-ctx.Done():
+				timer.Stop()
+				return retryError(method, attempts)
+			case <-timer.C:
+			}
+		}
+	}
+}
+
+// nextDelay reports whether the call should be retried after the given
+// number of attempts failed, the last one with err, and how long to wait
+// before the retry.
+func (i *RetryInterceptor) nextDelay(ctx context.Context, attempts int, err error) (time.Duration, bool) {
+	if attempts >= i.maxAttempts {
+		return 0, false
+	}
+
+	richErr, ok := statusdetails.FromError(err)
+	if !ok || !i.retryable(richErr) {
+		return 0, false
+	}
+
+	backoff := float64(i.initialBackoff)
+	for n := 1; n < attempts; n++ {
+		backoff *= i.multiplier
+	}
+	backoff = min(backoff, float64(i.maxBackoff))
+	delay := time.Duration(backoff * (1 - i.jitter*rand.Float64()))
+
+	// the server knows better when it will be able to serve the call again
+	if retryDelay, ok := richErr.RetryDelay(); ok {
+		delay = max(delay, retryDelay)
+	}
+
+	if deadline, ok := ctx.Deadline(); ok && time.Until(deadline) <= delay {
+		return 0, false
+	}
+	return delay, true
+}
+
+func (i *RetryInterceptor) retryable(err *statusdetails.RichError) bool {
+	if reason := err.Reason(); reason != "" {
+		if retryable, ok := i.reasons[reason]; ok {
+			return retryable
+		}
+	}
+	if _, ok := err.RetryDelay(); ok {
+		return true
+	}
+	return i.codes[err.Code()]
+}
+
+// RetryError is returned when a call failed for good after being retried;
+// calls failing on the first attempt return its error as is. It carries the
+// status of the last attempt, so it can be inspected like the error of a
+// single call.
+type RetryError struct {
+	Method   string
+	Attempts []error
+}
+
+func retryError(method string, attempts []error) error {
+	if len(attempts) == 1 {
+		return attempts[0]
+	}
+	return &RetryError{Method: method, Attempts: attempts}
+}
+
+func (e *RetryError) Error() string {
+	attempts := make([]string, 0, len(e.Attempts))
+	for n, err := range e.Attempts {
+		attempts = append(attempts, fmt.Sprintf("attempt %d: %v", n+1, err))
+	}
+	return fmt.Sprintf("%s failed after %d attempts: %s",
+		e.Method, len(e.Attempts), strings.Join(attempts, "; "))
+}
+
+func (e *RetryError) Unwrap() error {
+	return e.Attempts[len(e.Attempts)-1]
+}
+
+func (e *RetryError) GRPCStatus() *status.Status {
+	return status.Convert(e.Unwrap())
+}
@@ -0,0 +1,210 @@
+package interceptors
+
+import (
+	"context"
+	"errors"
+	"net"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	helloworldPb "github.com/amirsalarsafaei/proto-error-handling/autogenerated/go/helloworld"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"github.com/amirsalarsafaei/proto-error-handling/go/pkg/statusdetails"
+)
+
+// flakyService fails GetUser with errs, one per call, and succeeds once they
+// are used up.
+type flakyService struct {
+	helloworldPb.UnimplementedUserServiceServer
+	errs  []error
+	calls atomic.Int32
+}
+
+func (s *flakyService) GetUser(
+	ctx context.Context,
+	request *helloworldPb.GetUserRequest,
+) (*helloworldPb.GetUserResponse, error) {
+	if n := int(s.calls.Add(1)); n <= len(s.errs) {
+		return nil, s.errs[n-1]
+	}
+	return &helloworldPb.GetUserResponse{User: &helloworldPb.User{UserId: request.GetUserId()}}, nil
+}
+
+func serveFlaky(t *testing.T, service *flakyService, retry *RetryInterceptor) helloworldPb.UserServiceClient {
+	t.Helper()
+	server := grpc.NewServer()
+	helloworldPb.RegisterUserServiceServer(server, service)
+
+	lis := bufconn.Listen(1 << 20)
+	go server.Serve(lis)
+	t.Cleanup(server.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufconn",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return lis.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithUnaryInterceptor(retry.Unary()),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { conn.Close() })
+	return helloworldPb.NewUserServiceClient(conn)
+}
+
+func withReason(code codes.Code, reason string) error {
+	return statusdetails.StatusWithDetails(
+		status.New(code, code.String()),
+		&errdetails.ErrorInfo{Reason: reason, Domain: "example.com"},
+	).Err()
+}
+
+func withRetryDelay(code codes.Code, delay time.Duration) error {
+	return statusdetails.StatusWithDetails(
+		status.New(code, code.String()),
+		&errdetails.RetryInfo{RetryDelay: durationpb.New(delay)},
+	).Err()
+}
+
+func TestRetryClassification(t *testing.T) {
+	tests := []struct {
+		name  string
+		opts  []RetryOption
+		err   error
+		calls int32
+	}{
+		{"retryable code", nil, status.Error(codes.Unavailable, "unavailable"), 2},
+		{"other code", nil, status.Error(codes.InvalidArgument, "invalid"), 1},
+		{"aborted", nil, status.Error(codes.Aborted, "aborted"), 1},
+		{"replaced codes", []RetryOption{WithRetryableCodes(codes.Aborted)}, status.Error(codes.Unavailable, "unavailable"), 1},
+		{"retryable reason", []RetryOption{WithRetryableReason("BUSY", true)}, withReason(codes.FailedPrecondition, "BUSY"), 2},
+		{"not retryable reason", []RetryOption{WithRetryableReason("DOWN", false)}, withReason(codes.Unavailable, "DOWN"), 1},
+		{"unregistered reason", nil, withReason(codes.Unavailable, "DOWN"), 2},
+		{"retry info", nil, withRetryDelay(codes.FailedPrecondition, time.Millisecond), 2},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service := &flakyService{errs: []error{tt.err}}
+			opts := append([]RetryOption{WithBackoff(time.Millisecond, time.Millisecond, 1)}, tt.opts...)
+			client := serveFlaky(t, service, NewRetryInterceptor(opts...))
+
+			_, err := client.GetUser(context.Background(), &helloworldPb.GetUserRequest{UserId: "1"})
+			if calls := service.calls.Load(); calls != tt.calls {
+				t.Errorf("got %d calls, want %d", calls, tt.calls)
+			}
+			if tt.calls == 1 && status.Code(err) != status.Code(tt.err) {
+				t.Errorf("got %v, want the error of the call", err)
+			}
+			if tt.calls > 1 && err != nil {
+				t.Errorf("retried call failed: %v", err)
+			}
+		})
+	}
+}
+
+func TestRetryBackoff(t *testing.T) {
+	i := NewRetryInterceptor(
+		WithMaxAttempts(10),
+		WithBackoff(10*time.Millisecond, 50*time.Millisecond, 2),
+		WithJitter(0),
+	)
+	err := status.Error(codes.Unavailable, "unavailable")
+
+	want := []time.Duration{10, 20, 40, 50, 50}
+	for n, delay := range want {
+		got, retry := i.nextDelay(context.Background(), n+1, err)
+		if !retry || got != delay*time.Millisecond {
+			t.Errorf("after %d attempts, got %v (retry %t), want %v", n+1, got, retry, delay*time.Millisecond)
+		}
+	}
+
+	if _, retry := i.nextDelay(context.Background(), 10, err); retry {
+		t.Error("retried after the last attempt")
+	}
+}
+
+func TestRetryJitter(t *testing.T) {
+	i := NewRetryInterceptor(WithBackoff(100*time.Millisecond, time.Second, 2), WithJitter(0.5))
+	err := status.Error(codes.Unavailable, "unavailable")
+
+	for range 100 {
+		delay, _ := i.nextDelay(context.Background(), 1, err)
+		if delay < 50*time.Millisecond || delay > 100*time.Millisecond {
+			t.Fatalf("got delay %v, want between 50ms and 100ms", delay)
+		}
+	}
+}
+
+func TestRetryHonorsRetryInfo(t *testing.T) {
+	i := NewRetryInterceptor(WithBackoff(10*time.Millisecond, time.Second, 2), WithJitter(0))
+
+	delay, retry := i.nextDelay(context.Background(), 1, withRetryDelay(codes.Unavailable, 300*time.Millisecond))
+	if !retry || delay != 300*time.Millisecond {
+		t.Errorf("got %v (retry %t), want the delay of the RetryInfo", delay, retry)
+	}
+
+	// a backoff longer than the RetryInfo delay wins
+	delay, _ = i.nextDelay(context.Background(), 1, withRetryDelay(codes.Unavailable, time.Millisecond))
+	if delay != 10*time.Millisecond {
+		t.Errorf("got %v, want the backoff", delay)
+	}
+}
+
+func TestRetryStopsAtDeadline(t *testing.T) {
+	service := &flakyService{errs: []error{withRetryDelay(codes.Unavailable, time.Second)}}
+	client := serveFlaky(t, service, NewRetryInterceptor())
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	start := time.Now()
+	_, err := client.GetUser(ctx, &helloworldPb.GetUserRequest{UserId: "1"})
+	if status.Code(err) != codes.Unavailable {
+		t.Errorf("got %v, want the error of the only attempt", err)
+	}
+	if calls := service.calls.Load(); calls != 1 {
+		t.Errorf("got %d calls, want 1", calls)
+	}
+	if elapsed := time.Since(start); elapsed > 50*time.Millisecond {
+		t.Errorf("waited %v for a retry past the deadline", elapsed)
+	}
+}
+
+func TestRetryErrorListsAttempts(t *testing.T) {
+	service := &flakyService{errs: []error{
+		status.Error(codes.Unavailable, "first"),
+		status.Error(codes.Unavailable, "second"),
+		status.Error(codes.ResourceExhausted, "third"),
+	}}
+	client := serveFlaky(t, service, NewRetryInterceptor(
+		WithMaxAttempts(3),
+		WithBackoff(time.Millisecond, time.Millisecond, 1),
+	))
+
+	_, err := client.GetUser(context.Background(), &helloworldPb.GetUserRequest{UserId: "1"})
+	var retryErr *RetryError
+	if !errors.As(err, &retryErr) {
+		t.Fatalf("got %v, want a RetryError", err)
+	}
+	if len(retryErr.Attempts) != 3 || retryErr.Method != helloworldPb.UserService_GetUser_FullMethodName {
+		t.Errorf("got %d attempts of %s, want 3 of GetUser", len(retryErr.Attempts), retryErr.Method)
+	}
+	for _, message := range []string{"attempt 1: ", "first", "attempt 2: ", "second", "attempt 3: ", "third"} {
+		if !strings.Contains(err.Error(), message) {
+			t.Errorf("%q does not mention %q", err, message)
+		}
+	}
+	// the error is the status of the last attempt
+	if st := status.Convert(err); st.Code() != codes.ResourceExhausted || st.Message() != "third" {
+		t.Errorf("got status %v, want that of the last attempt", st)
+	}
+}
@@ -0,0 +1,96 @@
+package interceptors
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/amirsalarsafaei/proto-error-handling/go/pkg/constraints"
+)
+
+// ValidationInterceptor rejects requests that break the fieldspec constraints
+// of their fields before they reach the handler. Every violation is reported
+// with the error the interceptor is given for it, so the error interceptor
+// converts and localizes rejections like any other error.
+type ValidationInterceptor struct {
+	toError func(constraints.Violation) error
+	skipped map[string]bool
+}
+
+type ValidationOption func(*ValidationInterceptor)
+
+// WithSkippedMethods passes the requests of methods to their handlers
+// unchecked, e.g. for RPCs that report errors in-band rather than as a
+// status, which can check them with constraints.Check.
+func WithSkippedMethods(methods ...string) ValidationOption {
+	return func(i *ValidationInterceptor) {
+		for _, method := range methods {
+			i.skipped[method] = true
+		}
+	}
+}
+
+// NewValidationInterceptor returns an interceptor enforcing the constraints
+// of requests. toError returns the error a violation is reported with.
+func NewValidationInterceptor(toError func(constraints.Violation) error, opts ...ValidationOption) *ValidationInterceptor {
+	i := &ValidationInterceptor{
+		toError: toError,
+		skipped: make(map[string]bool),
+	}
+	for _, opt := range opts {
+		opt(i)
+	}
+	return i
+}
+
+func (i *ValidationInterceptor) Unary() grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req any,
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (any, error) {
+		if !i.skipped[info.FullMethod] {
+			if err := i.validate(req); err != nil {
+				return nil, err
+			}
+		}
+		return handler(ctx, req)
+	}
+}
+
+func (i *ValidationInterceptor) Stream() grpc.StreamServerInterceptor {
+	return func(
+		srv any,
+		ss grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		if i.skipped[info.FullMethod] {
+			return handler(srv, ss)
+		}
+		return handler(srv, &validatingStream{ServerStream: ss, interceptor: i})
+	}
+}
+
+func (i *ValidationInterceptor) validate(req any) error {
+	m, ok := req.(proto.Message)
+	if !ok {
+		return nil
+	}
+	return constraints.Check(m, i.toError)
+}
+
+// validatingStream validates every message the client sends.
+type validatingStream struct {
+	grpc.ServerStream
+	interceptor *ValidationInterceptor
+}
+
+func (s *validatingStream) RecvMsg(m any) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+	return s.interceptor.validate(m)
+}
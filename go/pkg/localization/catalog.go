@@ -0,0 +1,152 @@
+package localization
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/fs"
+	"path"
+	"sort"
+	"strings"
+	"text/template"
+
+	"golang.org/x/text/language"
+)
+
+// Catalog holds per-locale message templates keyed by error reason. Templates
+// use text/template syntax and are executed with the error metadata, e.g.
+// "Email {{.email}} is already in use".
+type Catalog struct {
+	defaultLocale language.Tag
+	locales       []language.Tag
+	messages      map[language.Tag]map[string]*template.Template
+	matcher       language.Matcher
+}
+
+// LoadCatalog reads every "<locale>.json" file in fsys. Each file holds a JSON
+// object mapping error reasons to message templates.
+func LoadCatalog(fsys fs.FS, defaultLocale string) (*Catalog, error) {
+	defaultTag, err := language.Parse(defaultLocale)
+	if err != nil {
+		return nil, fmt.Errorf("invalid default locale %q: %w", defaultLocale, err)
+	}
+
+	files, err := fs.Glob(fsys, "*.json")
+	if err != nil {
+		return nil, fmt.Errorf("could not list catalog files: %w", err)
+	}
+
+	c := &Catalog{
+		defaultLocale: defaultTag,
+		messages:      make(map[language.Tag]map[string]*template.Template),
+	}
+
+	for _, file := range files {
+		tag, err := language.Parse(strings.TrimSuffix(path.Base(file), ".json"))
+		if err != nil {
+			return nil, fmt.Errorf("invalid locale file name %q: %w", file, err)
+		}
+
+		data, err := fs.ReadFile(fsys, file)
+		if err != nil {
+			return nil, fmt.Errorf("could not read %q: %w", file, err)
+		}
+
+		var raw map[string]string
+		if err := json.Unmarshal(data, &raw); err != nil {
+			return nil, fmt.Errorf("could not parse %q: %w", file, err)
+		}
+
+		messages := make(map[string]*template.Template, len(raw))
+		for reason, text := range raw {
+			tmpl, err := template.New(reason).Option("missingkey=zero").Parse(text)
+			if err != nil {
+				return nil, fmt.Errorf("invalid template for %s in %q: %w", reason, file, err)
+			}
+			messages[reason] = tmpl
+		}
+		c.messages[tag] = messages
+	}
+
+	if _, ok := c.messages[defaultTag]; !ok {
+		return nil, fmt.Errorf("no catalog file for default locale %q", defaultLocale)
+	}
+
+	// the matcher falls back to its first tag, so the default locale goes first
+	c.locales = append(c.locales, defaultTag)
+	for tag := range c.messages {
+		if tag != defaultTag {
+			c.locales = append(c.locales, tag)
+		}
+	}
+	others := c.locales[1:]
+	sort.Slice(others, func(i, j int) bool {
+		return others[i].String() < others[j].String()
+	})
+	c.matcher = language.NewMatcher(c.locales)
+
+	return c, nil
+}
+
+// Reasons lists the reasons that have a message in any locale, sorted.
+func (c *Catalog) Reasons() []string {
+	seen := make(map[string]bool)
+	var reasons []string
+	for _, messages := range c.messages {
+		for reason := range messages {
+			if !seen[reason] {
+				seen[reason] = true
+				reasons = append(reasons, reason)
+			}
+		}
+	}
+	sort.Strings(reasons)
+	return reasons
+}
+
+// Localize renders the message for reason in the locale that best matches
+// acceptLanguage, given as Accept-Language header values. Locales are tried in
+// the caller's order of preference and then the default locale, so a reason
+// missing from a translation still gets a message. It returns the chosen
+// locale along with the message.
+func (c *Catalog) Localize(
+	reason string,
+	data map[string]string,
+	acceptLanguage ...string,
+) (locale string, message string, ok bool) {
+	for _, tag := range c.candidates(acceptLanguage) {
+		tmpl, ok := c.messages[tag][reason]
+		if !ok {
+			continue
+		}
+
+		var sb strings.Builder
+		if err := tmpl.Execute(&sb, data); err != nil {
+			continue
+		}
+		return tag.String(), sb.String(), true
+	}
+
+	return "", "", false
+}
+
+func (c *Catalog) candidates(acceptLanguage []string) []language.Tag {
+	var candidates []language.Tag
+	seen := make(map[language.Tag]bool)
+
+	preferred, _, _ := language.ParseAcceptLanguage(strings.Join(acceptLanguage, ","))
+	for _, tag := range preferred {
+		_, index, confidence := c.matcher.Match(tag)
+		if confidence == language.No {
+			continue
+		}
+		if matched := c.locales[index]; !seen[matched] {
+			seen[matched] = true
+			candidates = append(candidates, matched)
+		}
+	}
+
+	if !seen[c.defaultLocale] {
+		candidates = append(candidates, c.defaultLocale)
+	}
+	return candidates
+}
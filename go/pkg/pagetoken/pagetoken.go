@@ -0,0 +1,79 @@
+package pagetoken
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+)
+
+const (
+	issuedAtSize  = 8
+	signatureSize = 16
+)
+
+var (
+	ErrInvalid = errors.New("invalid page token")
+	ErrExpired = errors.New("page token expired")
+)
+
+// Codec turns pagination cursors into opaque page tokens. Tokens are signed,
+// so clients can't forge or alter them, and expire after a fixed lifetime.
+type Codec struct {
+	key []byte
+	ttl time.Duration
+}
+
+func NewCodec(key []byte, ttl time.Duration) *Codec {
+	return &Codec{
+		key: key,
+		ttl: ttl,
+	}
+}
+
+// Encode returns a token for cursor, which is marshaled as JSON.
+func (c *Codec) Encode(cursor any) (string, error) {
+	data, err := json.Marshal(cursor)
+	if err != nil {
+		return "", fmt.Errorf("could not marshal cursor: %w", err)
+	}
+
+	payload := binary.BigEndian.AppendUint64(nil, uint64(time.Now().Unix()))
+	payload = append(payload, data...)
+	return base64.RawURLEncoding.EncodeToString(append(payload, c.sign(payload)...)), nil
+}
+
+// Decode unmarshals the cursor of token into cursor. It returns ErrInvalid for
+// tokens that weren't issued by a codec with the same key and ErrExpired for
+// tokens older than the codec's lifetime.
+func (c *Codec) Decode(token string, cursor any) error {
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil || len(raw) < issuedAtSize+signatureSize {
+		return ErrInvalid
+	}
+
+	payload, signature := raw[:len(raw)-signatureSize], raw[len(raw)-signatureSize:]
+	if !hmac.Equal(signature, c.sign(payload)) {
+		return ErrInvalid
+	}
+
+	issuedAt := time.Unix(int64(binary.BigEndian.Uint64(payload[:issuedAtSize])), 0)
+	if time.Now().Sub(issuedAt) > c.ttl {
+		return ErrExpired
+	}
+
+	if err := json.Unmarshal(payload[issuedAtSize:], cursor); err != nil {
+		return ErrInvalid
+	}
+	return nil
+}
+
+func (c *Codec) sign(payload []byte) []byte {
+	mac := hmac.New(sha256.New, c.key)
+	mac.Write(payload)
+	return mac.Sum(nil)[:signatureSize]
+}
@@ -0,0 +1,187 @@
+// Package problem renders gRPC statuses as RFC 9457 problem details, so the
+// errors of a gRPC service can be served to HTTP clients.
+package problem
+
+import (
+	"encoding/json"
+	"log/slog"
+	"math"
+	"net/http"
+	"strconv"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/amirsalarsafaei/proto-error-handling/go/pkg/statusdetails"
+)
+
+const ContentType = "application/problem+json"
+
+type InvalidParam struct {
+	Name   string `json:"name"`
+	Reason string `json:"reason"`
+}
+
+type Resource struct {
+	Type string `json:"type"`
+	Name string `json:"name"`
+}
+
+type Precondition struct {
+	Type        string `json:"type"`
+	Subject     string `json:"subject"`
+	Description string `json:"description"`
+}
+
+// Details is a problem details object. Besides the members defined by RFC
+// 9457 it has extension members for the ErrorInfo, BadRequest, ResourceInfo
+// and PreconditionFailure details of the status.
+type Details struct {
+	Type     string `json:"type"`
+	Title    string `json:"title"`
+	Status   int    `json:"status"`
+	Detail   string `json:"detail,omitempty"`
+	Instance string `json:"instance,omitempty"`
+
+	Reason        string            `json:"reason,omitempty"`
+	Domain        string            `json:"domain,omitempty"`
+	Metadata      map[string]string `json:"metadata,omitempty"`
+	InvalidParams []InvalidParam    `json:"invalid-params,omitempty"`
+	Resources     []Resource        `json:"resources,omitempty"`
+	Preconditions []Precondition    `json:"preconditions,omitempty"`
+
+	// RetryAfter is sent as the Retry-After header.
+	RetryAfter time.Duration `json:"-"`
+}
+
+var httpStatuses = map[codes.Code]int{
+	codes.OK:                 http.StatusOK,
+	codes.Canceled:           499,
+	codes.Unknown:            http.StatusInternalServerError,
+	codes.InvalidArgument:    http.StatusBadRequest,
+	codes.DeadlineExceeded:   http.StatusGatewayTimeout,
+	codes.NotFound:           http.StatusNotFound,
+	codes.AlreadyExists:      http.StatusConflict,
+	codes.PermissionDenied:   http.StatusForbidden,
+	codes.ResourceExhausted:  http.StatusTooManyRequests,
+	codes.FailedPrecondition: http.StatusBadRequest,
+	codes.Aborted:            http.StatusConflict,
+	codes.OutOfRange:         http.StatusBadRequest,
+	codes.Unimplemented:      http.StatusNotImplemented,
+	codes.Internal:           http.StatusInternalServerError,
+	codes.Unavailable:        http.StatusServiceUnavailable,
+	codes.DataLoss:           http.StatusInternalServerError,
+	codes.Unauthenticated:    http.StatusUnauthorized,
+}
+
+// HTTPStatus returns the HTTP status code for a gRPC code, following the
+// mapping of grpc-gateway.
+func HTTPStatus(code codes.Code) int {
+	if httpStatus, ok := httpStatuses[code]; ok {
+		return httpStatus
+	}
+	return http.StatusInternalServerError
+}
+
+// Writer writes statuses as problem details.
+type Writer struct {
+	typeBase string
+}
+
+type Option func(*Writer)
+
+// WithTypeBase makes the type of problems with an ErrorInfo the base URI
+// followed by the reason. Without it, every problem has the type
+// "about:blank" and is identified by its status code only.
+func WithTypeBase(base string) Option {
+	return func(w *Writer) {
+		w.typeBase = base
+	}
+}
+
+func NewWriter(opts ...Option) *Writer {
+	w := &Writer{}
+	for _, opt := range opts {
+		opt(w)
+	}
+	return w
+}
+
+// FromStatus describes st as problem details. The detail is the localized
+// message of the status if it has one, and the status message otherwise.
+func (w *Writer) FromStatus(st *status.Status) Details {
+	richErr := statusdetails.FromStatus(st)
+	httpStatus := HTTPStatus(st.Code())
+
+	p := Details{
+		Type:   "about:blank",
+		Title:  http.StatusText(httpStatus),
+		Status: httpStatus,
+		Detail: st.Message(),
+	}
+
+	if info, ok := richErr.ErrorInfo(); ok {
+		p.Reason = info.Reason
+		p.Domain = info.Domain
+		p.Metadata = info.Metadata
+		if w.typeBase != "" && info.Reason != "" {
+			p.Type = w.typeBase + info.Reason
+			p.Title = st.Message()
+		}
+	}
+	if messages := richErr.LocalizedMessages(); len(messages) > 0 {
+		p.Detail = messages[0].GetMessage()
+	}
+
+	for _, v := range richErr.BadRequestViolations() {
+		p.InvalidParams = append(p.InvalidParams, InvalidParam{
+			Name:   v.GetField(),
+			Reason: v.GetDescription(),
+		})
+	}
+	for _, info := range richErr.ResourceInfo() {
+		p.Resources = append(p.Resources, Resource{
+			Type: info.GetResourceType(),
+			Name: info.GetResourceName(),
+		})
+	}
+	for _, v := range richErr.PreconditionViolations() {
+		p.Preconditions = append(p.Preconditions, Precondition{
+			Type:        v.GetType(),
+			Subject:     v.GetSubject(),
+			Description: v.GetDescription(),
+		})
+	}
+	if delay, ok := richErr.RetryDelay(); ok {
+		p.RetryAfter = delay
+	}
+
+	return p
+}
+
+// Write responds to an HTTP request with st as problem details.
+func (w *Writer) Write(rw http.ResponseWriter, r *http.Request, st *status.Status) {
+	w.WriteDetails(rw, r, w.FromStatus(st))
+}
+
+// WriteDetails responds to an HTTP request with p, for problems of the HTTP
+// layer that have no status to be described by.
+func (w *Writer) WriteDetails(rw http.ResponseWriter, r *http.Request, p Details) {
+	p.Instance = r.URL.Path
+
+	body, err := json.Marshal(p)
+	if err != nil {
+		slog.Error("could not marshal problem details", slog.Any("error", err))
+		http.Error(rw, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	rw.Header().Set("Content-Type", ContentType)
+	if p.RetryAfter > 0 {
+		// Retry-After only takes whole seconds
+		rw.Header().Set("Retry-After", strconv.Itoa(int(math.Ceil(p.RetryAfter.Seconds()))))
+	}
+	rw.WriteHeader(p.Status)
+	rw.Write(body)
+}
@@ -0,0 +1,183 @@
+package ratelimit
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"os"
+	"sync"
+	"time"
+)
+
+// sweepInterval is how often buckets that have refilled completely, and so
+// are no different from new ones, are dropped.
+const sweepInterval = time.Minute
+
+// DefaultMaxBuckets is the number of buckets kept when the Config sets none.
+const DefaultMaxBuckets = 100_000
+
+type Limit struct {
+	RequestsPerSecond float64 `json:"requests_per_second"`
+	Burst             int     `json:"burst"`
+}
+
+func (l Limit) String() string {
+	return fmt.Sprintf("%g requests per second with bursts of %d", l.RequestsPerSecond, l.Burst)
+}
+
+// Config sets the limits of every caller, per method. Methods are full gRPC
+// method names, e.g. "/hello_world.UserService/CreateUser".
+type Config struct {
+	// Default applies to methods without a limit of their own. Without it,
+	// those methods are not limited.
+	Default *Limit           `json:"default"`
+	Methods map[string]Limit `json:"methods"`
+	// MaxBuckets bounds the number of callers and methods tracked at once,
+	// DefaultMaxBuckets if zero. Once reached, new callers are rejected until
+	// idle buckets are dropped.
+	MaxBuckets int `json:"max_buckets"`
+}
+
+// LoadConfig reads a Config from the JSON file at path.
+func LoadConfig(path string) (Config, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return Config{}, fmt.Errorf("could not read %q: %w", path, err)
+	}
+
+	var cfg Config
+	if err := json.Unmarshal(data, &cfg); err != nil {
+		return Config{}, fmt.Errorf("could not parse %q: %w", path, err)
+	}
+
+	if cfg.MaxBuckets < 0 {
+		return Config{}, fmt.Errorf("max_buckets must not be negative, got %d", cfg.MaxBuckets)
+	}
+	if cfg.Default != nil {
+		if err := cfg.Default.validate(); err != nil {
+			return Config{}, fmt.Errorf("invalid default limit: %w", err)
+		}
+	}
+	for method, limit := range cfg.Methods {
+		if err := limit.validate(); err != nil {
+			return Config{}, fmt.Errorf("invalid limit for %s: %w", method, err)
+		}
+	}
+	return cfg, nil
+}
+
+func (l Limit) validate() error {
+	if l.RequestsPerSecond <= 0 || math.IsInf(l.RequestsPerSecond, 0) {
+		return fmt.Errorf("requests_per_second must be positive, got %g", l.RequestsPerSecond)
+	}
+	if l.Burst < 1 {
+		return fmt.Errorf("burst must be at least 1, got %d", l.Burst)
+	}
+	return nil
+}
+
+// Decision is the outcome of a request against its limit.
+type Decision struct {
+	Allowed bool
+	Limit   Limit
+	// RetryAfter is how long a rejected caller has to wait for the bucket to
+	// hold a token again.
+	RetryAfter time.Duration
+}
+
+type bucketKey struct {
+	caller string
+	method string
+}
+
+type bucket struct {
+	limit  Limit
+	tokens float64
+	last   time.Time
+}
+
+// Limiter enforces a Config with a token bucket per caller and method.
+type Limiter struct {
+	cfg       Config
+	buckets   map[bucketKey]*bucket
+	lastSweep time.Time
+	now       func() time.Time
+	mu        sync.Mutex
+}
+
+func NewLimiter(cfg Config) *Limiter {
+	if cfg.MaxBuckets == 0 {
+		cfg.MaxBuckets = DefaultMaxBuckets
+	}
+	return &Limiter{
+		cfg:       cfg,
+		buckets:   make(map[bucketKey]*bucket),
+		lastSweep: time.Now(),
+		now:       time.Now,
+	}
+}
+
+// Allow takes a token from the bucket of caller for method.
+func (l *Limiter) Allow(caller, method string) Decision {
+	limit, ok := l.cfg.Methods[method]
+	if !ok {
+		if l.cfg.Default == nil {
+			return Decision{Allowed: true}
+		}
+		limit = *l.cfg.Default
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.now()
+	if now.Sub(l.lastSweep) >= sweepInterval {
+		l.sweep(now)
+	}
+
+	key := bucketKey{caller: caller, method: method}
+	b, ok := l.buckets[key]
+	if !ok {
+		// a full limiter makes room with an early sweep, at most once a
+		// second so callers flooding it don't make every call a sweep
+		if len(l.buckets) >= l.cfg.MaxBuckets && now.Sub(l.lastSweep) >= time.Second {
+			l.sweep(now)
+		}
+		if len(l.buckets) >= l.cfg.MaxBuckets {
+			return Decision{
+				Limit:      limit,
+				RetryAfter: time.Duration(float64(time.Second) / limit.RequestsPerSecond),
+			}
+		}
+		b = &bucket{limit: limit, tokens: float64(limit.Burst), last: now}
+		l.buckets[key] = b
+	}
+
+	b.refill(now)
+	if b.tokens < 1 {
+		wait := (1 - b.tokens) / limit.RequestsPerSecond
+		return Decision{
+			Limit:      limit,
+			RetryAfter: time.Duration(wait * float64(time.Second)),
+		}
+	}
+
+	b.tokens--
+	return Decision{Allowed: true, Limit: limit}
+}
+
+func (l *Limiter) sweep(now time.Time) {
+	l.lastSweep = now
+
+	for key, b := range l.buckets {
+		if b.refill(now); b.tokens >= float64(b.limit.Burst) {
+			delete(l.buckets, key)
+		}
+	}
+}
+
+func (b *bucket) refill(now time.Time) {
+	elapsed := now.Sub(b.last).Seconds()
+	b.tokens = min(float64(b.limit.Burst), b.tokens+elapsed*b.limit.RequestsPerSecond)
+	b.last = now
+}
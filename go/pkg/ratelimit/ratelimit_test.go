@@ -0,0 +1,142 @@
+package ratelimit
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+const method = "/hello_world.UserService/CreateUser"
+
+// newTestLimiter returns a limiter whose clock is advanced by hand.
+func newTestLimiter(cfg Config) (*Limiter, func(time.Duration)) {
+	now := time.Unix(0, 0)
+	l := NewLimiter(cfg)
+	l.now = func() time.Time { return now }
+	l.lastSweep = now
+	return l, func(d time.Duration) { now = now.Add(d) }
+}
+
+func TestLimiterBurstAndRefill(t *testing.T) {
+	limit := Limit{RequestsPerSecond: 2, Burst: 3}
+	l, advance := newTestLimiter(Config{Methods: map[string]Limit{method: limit}})
+
+	for n := range limit.Burst {
+		if d := l.Allow("alice", method); !d.Allowed {
+			t.Fatalf("call %d of the burst rejected", n+1)
+		}
+	}
+	d := l.Allow("alice", method)
+	if d.Allowed {
+		t.Fatal("call past the burst allowed")
+	}
+	if d.RetryAfter != 500*time.Millisecond || d.Limit != limit {
+		t.Errorf("got retry after %v with %v, want 500ms with %v", d.RetryAfter, d.Limit, limit)
+	}
+
+	// callers and methods have buckets of their own
+	if d := l.Allow("bob", method); !d.Allowed {
+		t.Error("other caller rejected")
+	}
+	if d := l.Allow("alice", "/hello_world.UserService/GetUser"); !d.Allowed {
+		t.Error("method without a limit rejected")
+	}
+
+	advance(250 * time.Millisecond)
+	if d := l.Allow("alice", method); d.Allowed || d.RetryAfter != 250*time.Millisecond {
+		t.Errorf("got %+v half way to a token, want a rejection for 250ms", d)
+	}
+	advance(250 * time.Millisecond)
+	if d := l.Allow("alice", method); !d.Allowed {
+		t.Error("call rejected after a token refilled")
+	}
+
+	// the bucket refills up to the burst only
+	advance(time.Hour)
+	for range limit.Burst {
+		l.Allow("alice", method)
+	}
+	if d := l.Allow("alice", method); d.Allowed {
+		t.Error("bucket refilled past its burst")
+	}
+}
+
+func TestLimiterDefault(t *testing.T) {
+	l, _ := newTestLimiter(Config{Default: &Limit{RequestsPerSecond: 1, Burst: 1}})
+	if d := l.Allow("alice", method); !d.Allowed {
+		t.Fatal("first call rejected")
+	}
+	if d := l.Allow("alice", method); d.Allowed {
+		t.Error("default limit not enforced")
+	}
+}
+
+func TestLimiterSweepsIdleBuckets(t *testing.T) {
+	l, advance := newTestLimiter(Config{Default: &Limit{RequestsPerSecond: 1, Burst: 2}})
+	l.Allow("alice", method)
+	l.Allow("bob", method)
+	l.Allow("bob", method)
+
+	// bob's bucket still lacks a token at the sweep
+	advance(sweepInterval - 500*time.Millisecond)
+	l.Allow("bob", method)
+	l.Allow("bob", method)
+	advance(500 * time.Millisecond)
+	l.Allow("carol", method)
+
+	if _, ok := l.buckets[bucketKey{"alice", method}]; ok {
+		t.Error("idle bucket not swept")
+	}
+	if _, ok := l.buckets[bucketKey{"bob", method}]; !ok {
+		t.Error("bucket in use swept")
+	}
+}
+
+func TestLimiterMaxBuckets(t *testing.T) {
+	l, advance := newTestLimiter(Config{
+		Default:    &Limit{RequestsPerSecond: 1, Burst: 1},
+		MaxBuckets: 2,
+	})
+	l.Allow("alice", method)
+	l.Allow("bob", method)
+
+	d := l.Allow("carol", method)
+	if d.Allowed || d.RetryAfter != time.Second {
+		t.Errorf("got %+v for a caller past the limit of buckets, want a rejection for 1s", d)
+	}
+	if len(l.buckets) != 2 {
+		t.Errorf("got %d buckets, want 2", len(l.buckets))
+	}
+
+	// once the buckets have refilled, they make room for new callers
+	advance(time.Second)
+	if d := l.Allow("carol", method); !d.Allowed {
+		t.Error("new caller rejected after idle buckets could be swept")
+	}
+}
+
+func TestLoadConfig(t *testing.T) {
+	tests := []struct {
+		name    string
+		config  string
+		wantErr bool
+	}{
+		{"valid", `{"default": {"requests_per_second": 1, "burst": 2}, "max_buckets": 10}`, false},
+		{"zero rate", `{"methods": {"/a/B": {"requests_per_second": 0, "burst": 1}}}`, true},
+		{"zero burst", `{"default": {"requests_per_second": 1, "burst": 0}}`, true},
+		{"negative max buckets", `{"max_buckets": -1}`, true},
+		{"invalid JSON", `{`, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "ratelimits.json")
+			if err := os.WriteFile(path, []byte(tt.config), 0o600); err != nil {
+				t.Fatal(err)
+			}
+			if _, err := LoadConfig(path); (err != nil) != tt.wantErr {
+				t.Errorf("got error %v, want error %t", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,78 @@
+package statusdetails
+
+import (
+	"slices"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Rebuild turns resolved back into an error, for clients that receive errors
+// in-band instead of as a status. The error carries the status resolved would
+// be sent as, and matches with errors.Is the errors registered for its reason
+// and for the reasons of its violations. A zero code is looked up by reason.
+func (r *Registry) Rebuild(resolved ResolvedError) error {
+	var targets []error
+	addTarget := func(reason string) {
+		target, m, ok := r.lookupReason(reason)
+		if !ok {
+			return
+		}
+		if resolved.Code == codes.OK {
+			resolved.Code = m.Code
+		}
+		if target != nil && !slices.Contains(targets, target) {
+			targets = append(targets, target)
+		}
+	}
+
+	addTarget(resolved.Reason)
+	for _, v := range resolved.Violations {
+		addTarget(v.Reason)
+	}
+
+	if resolved.Code == codes.OK {
+		resolved.Code = codes.Unknown
+	}
+	if resolved.Domain == "" {
+		resolved.Domain = r.domain
+	}
+	return &rebuiltError{status: resolved.Status(), targets: targets}
+}
+
+// lookupReason returns the error and mapping registered for reason. The error
+// is nil for the aggregate and fallback mappings.
+func (r *Registry) lookupReason(reason string) (error, ErrorMapping, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	if r.aggregate != nil && r.aggregate.Reason == reason {
+		return nil, *r.aggregate, true
+	}
+	for _, entry := range r.entries {
+		if entry.mapping.Reason == reason {
+			return entry.target, entry.mapping, true
+		}
+	}
+	if r.fallback.Reason == reason {
+		return nil, r.fallback, true
+	}
+	return nil, ErrorMapping{}, false
+}
+
+type rebuiltError struct {
+	status  *status.Status
+	targets []error
+}
+
+func (e *rebuiltError) Error() string {
+	return e.status.Err().Error()
+}
+
+func (e *rebuiltError) GRPCStatus() *status.Status {
+	return e.status
+}
+
+func (e *rebuiltError) Unwrap() []error {
+	return e.targets
+}
@@ -0,0 +1,279 @@
+package statusdetails
+
+import (
+	"errors"
+	"maps"
+	"sync"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/protoadapt"
+
+	"github.com/amirsalarsafaei/proto-error-handling/go/pkg/apperrors"
+)
+
+type ErrorMapping struct {
+	Code    codes.Code
+	Reason  string
+	Field   string
+	Message string
+}
+
+type FieldViolation struct {
+	Field  string
+	Reason string
+	// Constraint is the ID of the declared field constraint the field breaks,
+	// if any, e.g. "string.max_len".
+	Constraint  string
+	Description string
+}
+
+type ResourceInfo struct {
+	Type        string
+	Name        string
+	Description string
+}
+
+// ResolvedError is the transport independent description of an error that
+// both status errors and in-band error responses are built from.
+type ResolvedError struct {
+	Code          codes.Code
+	Reason        string
+	Domain        string
+	Message       string
+	Metadata      map[string]string
+	Violations    []FieldViolation
+	Resource      *ResourceInfo
+	Preconditions []apperrors.PreconditionViolation
+}
+
+type registryEntry struct {
+	target  error
+	mapping ErrorMapping
+}
+
+type Registry struct {
+	domain    string
+	fallback  ErrorMapping
+	aggregate *ErrorMapping
+	entries   []registryEntry
+	mu        sync.RWMutex
+}
+
+func NewRegistry(domain string) *Registry {
+	return &Registry{
+		domain: domain,
+		fallback: ErrorMapping{
+			Code:    codes.Internal,
+			Reason:  "INTERNAL_ERROR",
+			Message: "Internal error",
+		},
+	}
+}
+
+func (r *Registry) Domain() string {
+	return r.domain
+}
+
+// Register maps every error matching target with errors.Is to m. Entries are
+// matched in registration order, so more specific errors should come first.
+func (r *Registry) Register(target error, m ErrorMapping) *Registry {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.entries = append(r.entries, registryEntry{target: target, mapping: m})
+	return r
+}
+
+// SetFallback replaces the mapping used for errors that match no entry.
+func (r *Registry) SetFallback(m ErrorMapping) *Registry {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.fallback = m
+	return r
+}
+
+// SetAggregate sets the mapping used for multi-errors, such as the result of
+// a validation that collects every violation. Without it, a multi-error is
+// described by the first registered entry it matches.
+func (r *Registry) SetAggregate(m ErrorMapping) *Registry {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.aggregate = &m
+	return r
+}
+
+// Reasons lists the reasons of every mapping in the registry, including the
+// fallback and aggregate mappings, in registration order.
+func (r *Registry) Reasons() []string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	reasons := []string{r.fallback.Reason}
+	if r.aggregate != nil {
+		reasons = append(reasons, r.aggregate.Reason)
+	}
+	for _, entry := range r.entries {
+		reasons = append(reasons, entry.mapping.Reason)
+	}
+	return reasons
+}
+
+func (r *Registry) Lookup(err error) (ErrorMapping, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	for _, entry := range r.entries {
+		if errors.Is(err, entry.target) {
+			return entry.mapping, true
+		}
+	}
+	return r.fallback, false
+}
+
+func (r *Registry) Resolve(err error) ResolvedError {
+	if err == nil {
+		return ResolvedError{Code: codes.OK, Domain: r.domain}
+	}
+
+	r.mu.RLock()
+	aggregate := r.aggregate
+	r.mu.RUnlock()
+
+	isAggregate := aggregate != nil && apperrors.IsMulti(err)
+
+	var m ErrorMapping
+	if isAggregate {
+		m = *aggregate
+	} else {
+		m, _ = r.Lookup(err)
+	}
+
+	resolved := ResolvedError{
+		Code:    m.Code,
+		Reason:  m.Reason,
+		Domain:  r.domain,
+		Message: m.Message,
+	}
+
+	for _, leaf := range apperrors.Flatten(err) {
+		leafMapping, _ := r.Lookup(leaf)
+		violation := FieldViolation{
+			Field:       leafMapping.Field,
+			Reason:      leafMapping.Reason,
+			Description: leafMapping.Message,
+		}
+
+		if domainErr, ok := apperrors.As(leaf); ok {
+			if domainErr.Field != "" {
+				violation.Field = domainErr.Field
+			}
+			if domainErr.Reason != "" {
+				violation.Reason = domainErr.Reason
+			}
+			violation.Constraint = domainErr.Constraint
+			if domainErr.Description != "" {
+				violation.Description = domainErr.Description
+			}
+			// the leaves of a multi-error may share metadata keys, so only a
+			// single error describes itself in the ErrorInfo; each leaf of a
+			// multi-error is told apart by its field violation
+			if !apperrors.IsMulti(err) {
+				resolved.Reason = violation.Reason
+				if domainErr.Domain != "" {
+					resolved.Domain = domainErr.Domain
+				}
+				resolved.Metadata = maps.Clone(domainErr.Metadata)
+			}
+
+			if domainErr.Resource != nil && resolved.Resource == nil {
+				resolved.Resource = &ResourceInfo{
+					Type:        domainErr.Resource.Type,
+					Name:        domainErr.Resource.Name,
+					Description: leafMapping.Message,
+				}
+			}
+			resolved.Preconditions = append(resolved.Preconditions, domainErr.Preconditions...)
+		}
+
+		if violation.Field != "" {
+			resolved.Violations = append(resolved.Violations, violation)
+		}
+	}
+
+	return resolved
+}
+
+// ToStatus converts err into a status carrying an ErrorInfo and, depending on
+// the error, a BadRequest listing every field violation, a ResourceInfo naming
+// the resource and a PreconditionFailure listing every failed precondition.
+// Errors that already carry a gRPC status are returned as is.
+func (r *Registry) ToStatus(err error) *status.Status {
+	if err == nil {
+		return status.New(codes.OK, "")
+	}
+	if st, ok := status.FromError(err); ok {
+		return st
+	}
+
+	return r.Resolve(err).Status()
+}
+
+func (e ResolvedError) Status() *status.Status {
+	details := []protoadapt.MessageV1{
+		&errdetails.ErrorInfo{
+			Reason:   e.Reason,
+			Domain:   e.Domain,
+			Metadata: e.Metadata,
+		},
+	}
+
+	if len(e.Violations) > 0 {
+		violations := make([]*errdetails.BadRequest_FieldViolation, 0, len(e.Violations))
+		for _, v := range e.Violations {
+			// a declared constraint is more specific than the reason
+			reason := v.Reason
+			if v.Constraint != "" {
+				reason = v.Constraint
+			}
+			violations = append(violations, &errdetails.BadRequest_FieldViolation{
+				Field:       v.Field,
+				Reason:      reason,
+				Description: v.Description,
+			})
+		}
+		details = append(details, &errdetails.BadRequest{FieldViolations: violations})
+	}
+
+	if e.Resource != nil {
+		details = append(details, &errdetails.ResourceInfo{
+			ResourceType: e.Resource.Type,
+			ResourceName: e.Resource.Name,
+			Description:  e.Resource.Description,
+		})
+	}
+
+	if len(e.Preconditions) > 0 {
+		violations := make([]*errdetails.PreconditionFailure_Violation, 0, len(e.Preconditions))
+		for _, v := range e.Preconditions {
+			violations = append(violations, &errdetails.PreconditionFailure_Violation{
+				Type:        v.Type,
+				Subject:     v.Subject,
+				Description: v.Description,
+			})
+		}
+		details = append(details, &errdetails.PreconditionFailure{Violations: violations})
+	}
+
+	return StatusWithDetails(status.New(e.Code, e.Message), details...)
+}
+
+// MapError reports the status for err along with whether err matched a
+// registered entry, so the registry can be plugged into error interceptors.
+func (r *Registry) MapError(err error) (*status.Status, bool) {
+	_, known := r.Lookup(err)
+	return r.ToStatus(err), known
+}
@@ -0,0 +1,79 @@
+package statusdetails
+
+import (
+	"errors"
+	"maps"
+	"slices"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+
+	"github.com/amirsalarsafaei/proto-error-handling/go/pkg/apperrors"
+)
+
+var errInvalidPath = errors.New("invalid path")
+
+func newTestRegistry() *Registry {
+	return NewRegistry("example.com").
+		Register(errInvalidPath, ErrorMapping{
+			Code:    codes.InvalidArgument,
+			Reason:  "INVALID_PATH",
+			Field:   "paths",
+			Message: "Invalid path",
+		}).
+		SetAggregate(ErrorMapping{
+			Code:    codes.InvalidArgument,
+			Reason:  "VALIDATION_FAILED",
+			Message: "Invalid request",
+		})
+}
+
+func TestResolveMetadata(t *testing.T) {
+	registry := newTestRegistry()
+
+	single := registry.Resolve(apperrors.New(errInvalidPath).WithMetadata("path", "bogus"))
+	if want := map[string]string{"path": "bogus"}; !maps.Equal(single.Metadata, want) {
+		t.Errorf("got metadata %v for a single error, want %v", single.Metadata, want)
+	}
+	if single.Reason != "INVALID_PATH" {
+		t.Errorf("got reason %s for a single error, want INVALID_PATH", single.Reason)
+	}
+
+	var errs apperrors.MultiError
+	errs.Append(
+		apperrors.New(errInvalidPath).WithField("paths[0]").WithMetadata("path", "bogus"),
+		apperrors.New(errInvalidPath).WithField("paths[1]").WithMetadata("path", "user_id"),
+	)
+	multi := registry.Resolve(errs.ErrorOrNil())
+	if multi.Metadata != nil {
+		t.Errorf("got metadata %v for a multi-error, want none", multi.Metadata)
+	}
+	if multi.Reason != "VALIDATION_FAILED" {
+		t.Errorf("got reason %s for a multi-error, want VALIDATION_FAILED", multi.Reason)
+	}
+	want := []FieldViolation{
+		{Field: "paths[0]", Reason: "INVALID_PATH", Description: "Invalid path"},
+		{Field: "paths[1]", Reason: "INVALID_PATH", Description: "Invalid path"},
+	}
+	if !slices.Equal(multi.Violations, want) {
+		t.Errorf("got violations %v, want %v", multi.Violations, want)
+	}
+}
+
+func TestStatusViolationReasons(t *testing.T) {
+	var errs apperrors.MultiError
+	errs.Append(
+		apperrors.New(errInvalidPath).WithField("paths[0]"),
+		apperrors.New(errInvalidPath).WithField("paths[1]").WithConstraint("string.pattern"),
+	)
+	richErr, _ := FromError(newTestRegistry().ToStatus(errs.ErrorOrNil()).Err())
+
+	var got []string
+	for _, v := range richErr.BadRequestViolations() {
+		got = append(got, v.GetField()+" "+v.GetReason())
+	}
+	// a declared constraint is reported by its ID instead of the reason
+	if want := []string{"paths[0] INVALID_PATH", "paths[1] string.pattern"}; !slices.Equal(got, want) {
+		t.Errorf("got violations %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,206 @@
+package statusdetails
+
+import (
+	"strings"
+	"time"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type ErrorInfo struct {
+	Reason   string
+	Domain   string
+	Metadata map[string]string
+}
+
+// RichError is a typed view over the details of a status error returned by a
+// gRPC call, so callers can branch on failures without type-switching over
+// status.Details themselves. Details that fail to decode are ignored.
+type RichError struct {
+	status               *status.Status
+	errorInfo            *errdetails.ErrorInfo
+	retryInfo            *errdetails.RetryInfo
+	badRequests          []*errdetails.BadRequest
+	quotaFailures        []*errdetails.QuotaFailure
+	preconditionFailures []*errdetails.PreconditionFailure
+	localizedMessages    []*errdetails.LocalizedMessage
+	helps                []*errdetails.Help
+	resourceInfos        []*errdetails.ResourceInfo
+	debugInfo            *errdetails.DebugInfo
+}
+
+// FromError decodes err into a RichError. It returns false if err is nil or
+// doesn't carry a gRPC status.
+func FromError(err error) (*RichError, bool) {
+	if err == nil {
+		return nil, false
+	}
+
+	st, ok := status.FromError(err)
+	if !ok {
+		return nil, false
+	}
+
+	return FromStatus(st), true
+}
+
+func FromStatus(st *status.Status) *RichError {
+	e := &RichError{status: st}
+
+	for _, detail := range st.Details() {
+		switch d := detail.(type) {
+		case *errdetails.ErrorInfo:
+			if e.errorInfo == nil {
+				e.errorInfo = d
+			}
+		case *errdetails.RetryInfo:
+			if e.retryInfo == nil {
+				e.retryInfo = d
+			}
+		case *errdetails.BadRequest:
+			e.badRequests = append(e.badRequests, d)
+		case *errdetails.QuotaFailure:
+			e.quotaFailures = append(e.quotaFailures, d)
+		case *errdetails.PreconditionFailure:
+			e.preconditionFailures = append(e.preconditionFailures, d)
+		case *errdetails.LocalizedMessage:
+			e.localizedMessages = append(e.localizedMessages, d)
+		case *errdetails.Help:
+			e.helps = append(e.helps, d)
+		case *errdetails.ResourceInfo:
+			e.resourceInfos = append(e.resourceInfos, d)
+		case *errdetails.DebugInfo:
+			if e.debugInfo == nil {
+				e.debugInfo = d
+			}
+		}
+	}
+
+	return e
+}
+
+func (e *RichError) Error() string {
+	return e.status.Err().Error()
+}
+
+func (e *RichError) GRPCStatus() *status.Status {
+	return e.status
+}
+
+func (e *RichError) Status() *status.Status {
+	return e.status
+}
+
+func (e *RichError) Code() codes.Code {
+	return e.status.Code()
+}
+
+func (e *RichError) Message() string {
+	return e.status.Message()
+}
+
+func (e *RichError) ErrorInfo() (ErrorInfo, bool) {
+	if e.errorInfo == nil {
+		return ErrorInfo{}, false
+	}
+
+	return ErrorInfo{
+		Reason:   e.errorInfo.GetReason(),
+		Domain:   e.errorInfo.GetDomain(),
+		Metadata: e.errorInfo.GetMetadata(),
+	}, true
+}
+
+// Reason returns the ErrorInfo reason, or an empty string without one.
+func (e *RichError) Reason() string {
+	return e.errorInfo.GetReason()
+}
+
+// FieldViolations groups the descriptions of every BadRequest violation by
+// field.
+func (e *RichError) FieldViolations() map[string][]string {
+	violations := make(map[string][]string)
+	for _, br := range e.badRequests {
+		for _, v := range br.GetFieldViolations() {
+			violations[v.GetField()] = append(violations[v.GetField()], v.GetDescription())
+		}
+	}
+	return violations
+}
+
+// BadRequestViolations lists every BadRequest violation in order.
+func (e *RichError) BadRequestViolations() []*errdetails.BadRequest_FieldViolation {
+	var violations []*errdetails.BadRequest_FieldViolation
+	for _, br := range e.badRequests {
+		violations = append(violations, br.GetFieldViolations()...)
+	}
+	return violations
+}
+
+func (e *RichError) RetryDelay() (time.Duration, bool) {
+	if e.retryInfo == nil || e.retryInfo.GetRetryDelay() == nil {
+		return 0, false
+	}
+	return e.retryInfo.GetRetryDelay().AsDuration(), true
+}
+
+func (e *RichError) QuotaViolations() []*errdetails.QuotaFailure_Violation {
+	var violations []*errdetails.QuotaFailure_Violation
+	for _, qf := range e.quotaFailures {
+		violations = append(violations, qf.GetViolations()...)
+	}
+	return violations
+}
+
+func (e *RichError) PreconditionViolations() []*errdetails.PreconditionFailure_Violation {
+	var violations []*errdetails.PreconditionFailure_Violation
+	for _, pf := range e.preconditionFailures {
+		violations = append(violations, pf.GetViolations()...)
+	}
+	return violations
+}
+
+func (e *RichError) ResourceInfo() []*errdetails.ResourceInfo {
+	return e.resourceInfos
+}
+
+func (e *RichError) DebugInfo() (*errdetails.DebugInfo, bool) {
+	return e.debugInfo, e.debugInfo != nil
+}
+
+func (e *RichError) LocalizedMessages() []*errdetails.LocalizedMessage {
+	return e.localizedMessages
+}
+
+// LocalizedMessage returns the message for locale, falling back to a message
+// in the same base language (e.g. "en" for "en-US") when there is no exact
+// match.
+func (e *RichError) LocalizedMessage(locale string) (string, bool) {
+	base, _, _ := strings.Cut(locale, "-")
+
+	var fallback *errdetails.LocalizedMessage
+	for _, lm := range e.localizedMessages {
+		if strings.EqualFold(lm.GetLocale(), locale) {
+			return lm.GetMessage(), true
+		}
+		lmBase, _, _ := strings.Cut(lm.GetLocale(), "-")
+		if fallback == nil && strings.EqualFold(lmBase, base) {
+			fallback = lm
+		}
+	}
+
+	if fallback != nil {
+		return fallback.GetMessage(), true
+	}
+	return "", false
+}
+
+func (e *RichError) HelpLinks() []*errdetails.Help_Link {
+	var links []*errdetails.Help_Link
+	for _, h := range e.helps {
+		links = append(links, h.GetLinks()...)
+	}
+	return links
+}
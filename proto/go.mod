@@ -5,6 +5,7 @@ go 1.22
 toolchain go1.22.7
 
 require (
+	connectrpc.com/connect v1.18.1
 	google.golang.org/grpc v1.69.2
 	google.golang.org/protobuf v1.35.1
 )
@@ -1,8 +1,10 @@
 use proto_error_interface::hello_world::{
     create_user_alt_response::Result as AltResult,
     user_service_server::{UserService, UserServiceServer},
-    CreateUserAltResponse, CreateUserRequest, CreateUserResponse,
-    ErrorDetails as InternalErrorDetails, UserData, UserStatus,
+    CreateUserAltResponse, CreateUserRequest, CreateUserResponse, DeleteUserRequest,
+    DeleteUserResponse, ErrorDetails as InternalErrorDetails, ErrorReason, GetUserRequest,
+    GetUserResponse, ListUsersRequest, ListUsersResponse, UpdateUserRequest, UpdateUserResponse,
+    UserData, UserStatus,
 };
 use std::{collections::HashMap, sync::Arc};
 use tokio::sync::Mutex;
@@ -87,6 +89,17 @@ impl UserRepository {
             .iter()
             .any(|u| u.id == user.id || u.email == user.email || u.username == user.username)
     }
+
+    pub async fn duplicate_reason(&self, user: &User) -> Option<ErrorReason> {
+        let users = self.users.lock().await;
+        if users.iter().any(|u| u.email == user.email) {
+            Some(ErrorReason::DuplicateEmail)
+        } else if users.iter().any(|u| u.username == user.username) {
+            Some(ErrorReason::DuplicateUsername)
+        } else {
+            None
+        }
+    }
 }
 
 pub struct UserServiceImpl {
@@ -167,19 +180,21 @@ impl UserService for UserServiceImpl {
             Err(e) => {
                 return Ok(Response::new(CreateUserAltResponse {
                     result: Some(AltResult::Error(InternalErrorDetails {
-                        code: "VALIDATION_ERROR".to_string(),
+                        code: ErrorReason::ValidationFailed as i32,
                         message: e.to_string(),
+                        field_violations: Vec::new(),
                     })),
                 }));
             }
         };
 
         // Check if user already exists
-        if self.repository.exists(&user).await {
+        if let Some(reason) = self.repository.duplicate_reason(&user).await {
             return Ok(Response::new(CreateUserAltResponse {
                 result: Some(AltResult::Error(InternalErrorDetails {
-                    code: "ALREADY_EXISTS".to_string(),
+                    code: reason as i32,
                     message: "User with this email or username already exists".to_string(),
+                    field_violations: Vec::new(),
                 })),
             }));
         }
@@ -193,10 +208,39 @@ impl UserService for UserServiceImpl {
             })),
             Err(e) => Ok(Response::new(CreateUserAltResponse {
                 result: Some(AltResult::Error(InternalErrorDetails {
-                    code: "CREATION_ERROR".to_string(),
+                    code: ErrorReason::InternalError as i32,
                     message: format!("Failed to create user: {}", e),
+                    field_violations: Vec::new(),
                 })),
             })),
         }
     }
+
+    async fn get_user(
+        &self,
+        _request: Request<GetUserRequest>,
+    ) -> Result<Response<GetUserResponse>, Status> {
+        Err(Status::unimplemented("GetUser is not implemented"))
+    }
+
+    async fn update_user(
+        &self,
+        _request: Request<UpdateUserRequest>,
+    ) -> Result<Response<UpdateUserResponse>, Status> {
+        Err(Status::unimplemented("UpdateUser is not implemented"))
+    }
+
+    async fn delete_user(
+        &self,
+        _request: Request<DeleteUserRequest>,
+    ) -> Result<Response<DeleteUserResponse>, Status> {
+        Err(Status::unimplemented("DeleteUser is not implemented"))
+    }
+
+    async fn list_users(
+        &self,
+        _request: Request<ListUsersRequest>,
+    ) -> Result<Response<ListUsersResponse>, Status> {
+        Err(Status::unimplemented("ListUsers is not implemented"))
+    }
 }